@@ -46,9 +46,19 @@ var (
 		"grpc_listen_port":    8191,
 		"grpcweb_listen_port": 8190,
 
+		"grpc_tls_enable":    false,
+		"grpc_tls_cert_file": "",
+		"grpc_tls_key_file":  "",
+
+		"metrics_disable": false,
+
+		"auth_tokens":             "",
+		"grpcweb_allowed_origins": "*",
+
 		"usb2snes_disable":      false,
 		"usb2snes_listen_addrs": "0.0.0.0:23074",
 		"fxpakpro_disable":      false,
+		"fxpakpro_aliases":      "",
 
 		"retroarch_disable":    false,
 		"retroarch_hosts":      "localhost:55355",
@@ -59,6 +69,21 @@ var (
 
 		"mock_enable": false,
 
+		"record_enable": false,
+		"record_dir":    "",
+		"replay_enable": false,
+		"replay_dir":    "",
+
+		"sram_backup_enable":           false,
+		"sram_backup_interval_seconds": 60,
+		"sram_backup_keep":             100,
+		"sram_backup_kinds":            "fxpakpro",
+
+		"read_coalesce_disable": false,
+		"read_cache_frames":     0,
+
+		"cheats_freeze_frames": 2,
+
 		// sni_emunw_hosts is set dynamically when initializing the driver and initialization is conditioned on nwa_disable_old_range
 		// We are not setting it here
 		"emunw_disable":    false,
@@ -15,8 +15,10 @@ import (
 	"sni/devices/snes/drivers/fxpakpro"
 	"sni/devices/snes/drivers/luabridge"
 	"sni/devices/snes/drivers/mock"
+	"sni/devices/snes/drivers/replay"
 	"sni/devices/snes/drivers/retroarch"
 	"sni/services/grpcimpl"
+	"sni/services/srambackup"
 	"sni/services/usb2snes"
 )
 
@@ -94,10 +96,12 @@ func main() {
 	luabridge.DriverInit()
 	retroarch.DriverInit()
 	mock.DriverInit()
+	replay.DriverInit()
 
 	// start the servers:
 	grpcimpl.StartGrpcServer()
 	usb2snes.StartHttpServer()
+	srambackup.Start()
 
 	// start up a systray:
 	tray.CreateSystray()
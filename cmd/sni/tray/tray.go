@@ -3,6 +3,7 @@
 package tray
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -158,8 +159,8 @@ func (t *Tray) HandleNextAction() {
 		break
 
 	case <-t.refresh.ClickedCh:
-		// this must not block the main thread
-		RefreshDeviceList()
+		// this must not block the main thread; the device list is updated by the devices observer:
+		go devices.DetectDevices()
 		break
 
 	case <-t.appsReload.ClickedCh:
@@ -376,27 +377,14 @@ func trayStart() {
 		maintray.updateConsole()
 	}))
 
-	// refresh device list periodically:
+	// update the device list whenever devices are detected, without detecting them periodically just for the tray:
+	events := devices.ObserveDevices(context.Background())
 	go func() {
 		defer util.Recover()
 
-		refreshPeriod := time.Tick(time.Second * 2)
-		for range refreshPeriod {
-			RefreshDeviceList()
+		for ev := range events {
+			UpdateDeviceList(ev.Devices)
 		}
 	}()
-}
-
-func RefreshDeviceList() {
-	descriptors := make([]devices.DeviceDescriptor, 0, 10)
-	for _, named := range devices.Drivers() {
-		d, err := named.Driver.Detect()
-		if err != nil {
-			continue
-		}
-
-		descriptors = append(descriptors, d...)
-	}
-
-	UpdateDeviceList(descriptors)
+	go devices.DetectDevices()
 }
@@ -9,7 +9,9 @@ import (
 	"net/url"
 	"sni/cmd/sni/config"
 	"sni/protos/sni"
+	"time"
 
+	"github.com/alttpo/snes/timing"
 	"google.golang.org/grpc/codes"
 )
 
@@ -25,12 +27,19 @@ type AutoCloseableDevice interface {
 
 	URI() *url.URL
 	DeviceKey() string
+
+	WatchMemory(ctx context.Context, interval time.Duration, reads ...MemoryReadRequest) (<-chan MemoryWatchEvent, error)
+
+	AcquireLease(ctx context.Context, token string, duration time.Duration, wait bool) (newToken string, expires time.Time, err error)
+	ReleaseLease(ctx context.Context, token string) error
+	Leased() bool
 }
 
 type autoCloseableDevice struct {
 	container DeviceContainer
 	uri       *url.URL
 	deviceKey string
+	state     *deviceState
 
 	logger *log.Logger
 }
@@ -58,17 +67,32 @@ func NewAutoCloseableDevice(container DeviceContainer, uri *url.URL, deviceKey s
 		)
 	}
 
-	return &autoCloseableDevice{
+	a := &autoCloseableDevice{
 		container: container,
 		uri:       uri,
 		deviceKey: deviceKey,
+		state:     deviceStateFor(uri, deviceKey),
 		logger:    logger,
 	}
+	if config.Config.GetBool("record_enable") {
+		return &recordingDevice{AutoCloseableDevice: a, device: a}
+	}
+	return a
 }
 
 type deviceUser func(ctx context.Context, device Device) error
 
+// ensureOpened waits for any lease held by another client to end and then calls use with the opened device.
 func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser) (err error) {
+	if err = a.state.lease.await(ctx); err != nil {
+		return
+	}
+
+	return a.useDevice(ctx, use)
+}
+
+// useDevice calls use with the opened device, opening it first if necessary.
+func (a *autoCloseableDevice) useDevice(ctx context.Context, use deviceUser) (err error) {
 	b := a.container
 	deviceKey := a.deviceKey
 
@@ -88,11 +112,14 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 			log.Printf("autoCloseableDevice.ensureOpened(): device.Close(): %v\n", oerr)
 		}
 		b.DeleteDevice(a.deviceKey)
+		a.state.reads.invalidate()
+		a.state.stopRecording()
 		return
 	}
 
 	if device.IsClosed() {
 		b.DeleteDevice(a.deviceKey)
+		a.state.stopRecording()
 	}
 	return
 }
@@ -118,10 +145,13 @@ func (a *autoCloseableDevice) Close() error {
 		a.logger.Printf("Close() } -> (%#v)\n", err)
 	}
 	a.container.DeleteDevice(a.deviceKey)
+	a.state.reads.invalidate()
+	a.state.stopRecording()
 	return err
 }
 
 func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
+	defer a.state.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetSystem() {\n")
@@ -136,6 +166,7 @@ func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
 }
 
 func (a *autoCloseableDevice) ResetToMenu(ctx context.Context) (err error) {
+	defer a.state.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetToMenu() {\n")
@@ -206,28 +237,81 @@ func (a *autoCloseableDevice) RequiresMemoryMappingForAddress(ctx context.Contex
 }
 
 func (a *autoCloseableDevice) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
-	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+	// check the lease before coalescing since the coalesced read is issued with another caller's context:
+	if err = a.state.lease.await(ctx); err != nil {
+		return
+	}
+
+	if config.Config.GetBool("read_coalesce_disable") {
+		return a.readMemory(ctx, reads...)
+	}
+	// replayed reads must reach the device exactly as they were recorded, i.e. neither merged nor cached:
+	if a.replaying(ctx) {
+		return a.readMemory(ctx, reads...)
+	}
+
+	cacheFor := time.Duration(config.Config.GetInt("read_cache_frames")) * timing.Frame
+	return a.state.reads.read(ctx, cacheFor, reads, a.readMemory)
+}
+
+func (a *autoCloseableDevice) readMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	err = a.useDevice(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("MultiReadMemory(%#v) {\n", reads)
 		}
+		start := time.Now()
 		rsp, err = device.MultiReadMemory(ctx, reads...)
+		observeDeviceOperation(a.uri.String(), "read", start, err)
 		if a.logger != nil {
 			a.logger.Printf("MultiReadMemory(%#v) } -> (%#v, %#v)\n", reads, rsp, err)
 		}
+		if err == nil {
+			n := 0
+			for i := range rsp {
+				n += len(rsp[i].Data)
+			}
+			deviceReadBytes.WithLabelValues(a.uri.String()).Add(float64(n))
+		}
 		return
 	})
 	return
 }
 
 func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	var conditional bool
+	if conditional, err = hasConditionalWrites(writes); err != nil {
+		return
+	}
+
+	defer a.state.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		// serialize writes so that no other write can interleave with the compare and write of a conditional write:
+		a.state.writeMu.Lock()
+		defer a.state.writeMu.Unlock()
+
 		if a.logger != nil {
 			a.logger.Printf("MultiWriteMemory(%#v) {\n", writes)
 		}
-		rsp, err = device.MultiWriteMemory(ctx, writes...)
+		start := time.Now()
+		if conditional {
+			rsp, err = compareAndSwap(ctx, device, writes)
+			observeDeviceOperation(a.uri.String(), "compare_and_swap", start, err)
+		} else {
+			rsp, err = device.MultiWriteMemory(ctx, writes...)
+			observeDeviceOperation(a.uri.String(), "write", start, err)
+		}
 		if a.logger != nil {
 			a.logger.Printf("MultiWriteMemory(%#v) } -> (%#v, %#v)\n", writes, rsp, err)
 		}
+		if err == nil {
+			n := 0
+			for i := range rsp {
+				if !rsp[i].CompareFailed {
+					n += rsp[i].Size
+				}
+			}
+			deviceWriteBytes.WithLabelValues(a.uri.String()).Add(float64(n))
+		}
 		return
 	})
 	return
@@ -360,6 +444,7 @@ func (a *autoCloseableDevice) GetFile(ctx context.Context, path string, w io.Wri
 }
 
 func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err error) {
+	defer a.state.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -378,6 +463,7 @@ func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err er
 }
 
 func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error) {
+	defer a.state.reads.invalidate()
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		nwa, ok := device.(DeviceNWA)
 		if !ok {
@@ -0,0 +1,94 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// hasConditionalWrites validates the Expected bytes of all writes and reports if any write is conditional.
+func hasConditionalWrites(writes []MemoryWriteRequest) (conditional bool, err error) {
+	for j, write := range writes {
+		if write.Expected == nil {
+			continue
+		}
+		if actual, expected := len(write.Expected), len(write.Data); actual != expected {
+			err = WithCode(codes.InvalidArgument, fmt.Errorf("write[%d] expected bytes must be the same length as data; actual %d expected %d", j, actual, expected))
+			return
+		}
+		conditional = true
+	}
+	return
+}
+
+// compareAndSwap performs the conditional writes natively if the device supports it. Otherwise, the current contents
+// of memory are read first and only the writes whose expected bytes match are issued. The latter is only atomic with
+// respect to other clients of SNI, since the caller holds the device's write lock, and not to the system itself.
+func compareAndSwap(ctx context.Context, device Device, writes []MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	if cas, ok := device.(DeviceCompareAndSwap); ok {
+		return cas.MultiCompareAndSwapMemory(ctx, writes...)
+	}
+
+	reads := make([]MemoryReadRequest, 0, len(writes))
+	readIndex := make([]int, len(writes))
+	for j, write := range writes {
+		readIndex[j] = -1
+		if write.Expected == nil {
+			continue
+		}
+		readIndex[j] = len(reads)
+		reads = append(reads, MemoryReadRequest{
+			RequestAddress: write.RequestAddress,
+			Size:           len(write.Expected),
+		})
+	}
+
+	var rrsp []MemoryReadResponse
+	rrsp, err = device.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		return
+	}
+	if actual, expected := len(rrsp), len(reads); actual != expected {
+		err = WithCode(codes.Internal, fmt.Errorf("compare read must have equal number of responses and requests; actual %d expected %d", actual, expected))
+		return
+	}
+
+	rsp = make([]MemoryWriteResponse, len(writes))
+	matched := make([]MemoryWriteRequest, 0, len(writes))
+	matchedIndex := make([]int, 0, len(writes))
+	for j, write := range writes {
+		if i := readIndex[j]; i >= 0 && !bytes.Equal(rrsp[i].Data, write.Expected) {
+			rsp[j] = MemoryWriteResponse{
+				RequestAddress: write.RequestAddress,
+				DeviceAddress:  rrsp[i].DeviceAddress,
+				Size:           0,
+				CompareFailed:  true,
+			}
+			continue
+		}
+
+		matched = append(matched, write)
+		matchedIndex = append(matchedIndex, j)
+	}
+
+	if len(matched) == 0 {
+		return
+	}
+
+	var wrsp []MemoryWriteResponse
+	wrsp, err = device.MultiWriteMemory(ctx, matched...)
+	if err != nil {
+		return nil, err
+	}
+	if actual, expected := len(wrsp), len(matched); actual != expected {
+		err = WithCode(codes.Internal, fmt.Errorf("write must have equal number of responses and requests; actual %d expected %d", actual, expected))
+		return nil, err
+	}
+	for i, j := range matchedIndex {
+		rsp[j] = wrsp[i]
+	}
+
+	return
+}
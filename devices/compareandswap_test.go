@@ -0,0 +1,58 @@
+package devices
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestMultiWriteMemory_CompareAndSwap(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+	fake.poke(0x10, 0xAA)
+	fake.poke(0x20, 0xBB)
+
+	rsp, err := device.MultiWriteMemory(
+		context.Background(),
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x10}, Data: []byte{0x01}, Expected: []byte{0xAA}},
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x20}, Data: []byte{0x02}, Expected: []byte{0x00}},
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x30}, Data: []byte{0x03, 0x04}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp[0].CompareFailed || rsp[0].Size != 1 {
+		t.Errorf("write[0] must succeed; got %+v", rsp[0])
+	}
+	if !rsp[1].CompareFailed || rsp[1].Size != 0 {
+		t.Errorf("write[1] must fail to compare; got %+v", rsp[1])
+	}
+	if rsp[2].CompareFailed || rsp[2].Size != 2 {
+		t.Errorf("unconditional write[2] must succeed; got %+v", rsp[2])
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.memory[0x10] != 0x01 {
+		t.Errorf("write[0] not written")
+	}
+	if fake.memory[0x20] != 0xBB {
+		t.Errorf("write[1] must not be written")
+	}
+	if fake.memory[0x30] != 0x03 || fake.memory[0x31] != 0x04 {
+		t.Errorf("write[2] not written")
+	}
+}
+
+func TestMultiWriteMemory_CompareAndSwapLengthMismatch(t *testing.T) {
+	_, device := newFakeMemoryDevice(t)
+
+	_, err := device.MultiWriteMemory(
+		context.Background(),
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x10}, Data: []byte{0x01, 0x02}, Expected: []byte{0xAA}},
+	)
+	if !isCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument; got %v", err)
+	}
+}
@@ -82,6 +82,8 @@ func (b *deviceContainer) OpenDevice(deviceKey string, uri *url.URL) (device Dev
 		return
 	}
 
+	deviceStateFor(uri, deviceKey).startRecording(deviceKey, uri, device)
+
 	b.devicesMap[deviceKey] = device
 	b.devicesRw.Unlock()
 	return
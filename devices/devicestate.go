@@ -0,0 +1,44 @@
+package devices
+
+import (
+	"net/url"
+	"sync"
+)
+
+// deviceState holds state shared by all AutoCloseableDevice instances that refer to the same device.
+// AutoCloseableDevice instances are created per request by Driver.Device so any state that must outlive
+// a single request (e.g. background pollers) lives here instead.
+type deviceState struct {
+	mu sync.Mutex
+
+	// poller is non-nil while there are active WatchMemory subscriptions:
+	poller *memoryPoller
+	// recording is non-nil while the opened device is being recorded:
+	recording *recording
+
+	reads readCoalescer
+	lease deviceLease
+
+	// writeMu serializes all writes to the device:
+	writeMu sync.Mutex
+}
+
+var (
+	deviceStatesMu sync.Mutex
+	deviceStates   = make(map[string]*deviceState)
+)
+
+// deviceStateFor returns the shared deviceState for the device identified by the uri scheme and device key.
+func deviceStateFor(uri *url.URL, deviceKey string) *deviceState {
+	key := uri.Scheme + ":" + deviceKey
+
+	deviceStatesMu.Lock()
+	defer deviceStatesMu.Unlock()
+
+	s, ok := deviceStates[key]
+	if !ok {
+		s = &deviceState{}
+		deviceStates[key] = s
+	}
+	return s
+}
@@ -0,0 +1,267 @@
+package devices
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sni/util"
+	"sync"
+	"time"
+)
+
+type DeviceEventType int
+
+const (
+	DeviceAdded DeviceEventType = iota
+	DeviceRemoved
+	DeviceChanged
+)
+
+func (t DeviceEventType) String() string {
+	switch t {
+	case DeviceAdded:
+		return "added"
+	case DeviceRemoved:
+		return "removed"
+	case DeviceChanged:
+		return "changed"
+	default:
+		return "unknown"
+	}
+}
+
+// DeviceEvent describes a device that was added, removed or whose descriptor changed. For removed devices, Descriptor
+// is the last descriptor detected.
+type DeviceEvent struct {
+	Type       DeviceEventType
+	Descriptor DeviceDescriptor
+}
+
+// DeviceWatchEvent is delivered to WatchDevices subscribers whenever the set of detected devices changes.
+type DeviceWatchEvent struct {
+	// Events lists the changes since the previous DeviceWatchEvent delivered:
+	Events []DeviceEvent
+	// Devices is the full list of devices detected, in driver display order:
+	Devices []DeviceDescriptor
+}
+
+// deviceDetectInterval is how often all drivers are asked to detect devices while there are subscribers:
+var deviceDetectInterval = time.Second * 2
+
+type deviceSubscriber struct {
+	ctx context.Context
+	// passive subscribers receive events but do not keep the detector running:
+	passive bool
+	// buffered with capacity 1; only the detector goroutine sends to and closes it:
+	events chan DeviceWatchEvent
+
+	// sent is the device list of the most recent event queued:
+	sent []DeviceDescriptor
+	// base is the device list the subscriber had before the most recent event queued:
+	base []DeviceDescriptor
+}
+
+type deviceDetector struct {
+	mu          sync.Mutex
+	running     bool
+	detected    bool
+	current     []DeviceDescriptor
+	subscribers []*deviceSubscriber
+}
+
+var detector = &deviceDetector{}
+
+// WatchDevices subscribes to device hot-plug events. Devices are detected in the background by all registered drivers
+// while there is at least one subscriber. The first event lists all devices currently detected as added. If the
+// subscriber falls behind, pending events are merged so that only the net changes are delivered. The channel is
+// closed once ctx is done.
+func WatchDevices(ctx context.Context) <-chan DeviceWatchEvent {
+	return detector.subscribe(ctx, false)
+}
+
+// ObserveDevices is WatchDevices without causing devices to be detected: events are only delivered when devices are
+// detected for WatchDevices subscribers or by DetectDevices.
+func ObserveDevices(ctx context.Context) <-chan DeviceWatchEvent {
+	return detector.subscribe(ctx, true)
+}
+
+// DetectDevices detects devices now, delivers the changes to all subscribers and returns the devices detected.
+func DetectDevices() (current []DeviceDescriptor) {
+	w := detector
+	current = detect()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.prune()
+	w.publish(current)
+	return
+}
+
+func (w *deviceDetector) subscribe(ctx context.Context, passive bool) <-chan DeviceWatchEvent {
+	s := &deviceSubscriber{
+		ctx:     ctx,
+		passive: passive,
+		events:  make(chan DeviceWatchEvent, 1),
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// forget the devices detected for subscribers that have since gone away rather than deliver them stale:
+	w.prune()
+	w.subscribers = append(w.subscribers, s)
+	if w.detected {
+		s.post(w.current)
+	}
+	if !passive && !w.running {
+		w.running = true
+		go w.run()
+	}
+
+	return s.events
+}
+
+func (w *deviceDetector) run() {
+	defer util.Recover()
+
+	ticker := time.NewTicker(deviceDetectInterval)
+	defer ticker.Stop()
+
+	for {
+		if !w.keepRunning() {
+			return
+		}
+
+		current := detect()
+
+		w.mu.Lock()
+		w.publish(current)
+		w.mu.Unlock()
+
+		<-ticker.C
+	}
+}
+
+// publish records the devices detected and delivers the changes to all subscribers. Must be called with w.mu held.
+func (w *deviceDetector) publish(current []DeviceDescriptor) {
+	w.current = current
+	w.detected = true
+	for _, s := range w.subscribers {
+		s.post(current)
+	}
+}
+
+// keepRunning prunes subscribers that have gone away. Returns false when no active subscribers remain, in which case
+// the detector goroutine must exit.
+func (w *deviceDetector) keepRunning() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.prune() > 0 {
+		return true
+	}
+
+	w.running = false
+	return false
+}
+
+// prune removes subscribers that have gone away and returns the number of active subscribers remaining. Must be
+// called with w.mu held.
+func (w *deviceDetector) prune() (active int) {
+	subscribers := w.subscribers[:0]
+	for _, s := range w.subscribers {
+		if s.ctx.Err() != nil {
+			close(s.events)
+			continue
+		}
+		subscribers = append(subscribers, s)
+		if !s.passive {
+			active++
+		}
+	}
+	for i := len(subscribers); i < len(w.subscribers); i++ {
+		w.subscribers[i] = nil
+	}
+	w.subscribers = subscribers
+
+	if len(w.subscribers) == 0 {
+		w.detected = false
+		w.current = nil
+	}
+
+	return
+}
+
+// detect asks all drivers to detect devices. Drivers that fail to detect contribute no devices.
+func detect() (current []DeviceDescriptor) {
+	current = make([]DeviceDescriptor, 0, 10)
+	for _, named := range Drivers() {
+		descriptors, err := named.Detect()
+		if err != nil {
+			log.Printf("devices: %s: detect: %v\n", named.Name, err)
+			continue
+		}
+
+		current = append(current, descriptors...)
+	}
+
+	return
+}
+
+// post delivers the changes between what the subscriber has and current without blocking. If the subscriber has not
+// yet consumed the previous event, it is replaced with one covering the changes of both.
+func (s *deviceSubscriber) post(current []DeviceDescriptor) {
+	select {
+	case <-s.events:
+		// previous event was not consumed; keep diffing against the same base
+	default:
+		s.base = s.sent
+	}
+
+	// the first event is always sent so the subscriber knows detection has completed:
+	events := diffDevices(s.base, current)
+	if len(events) == 0 && s.sent != nil {
+		s.sent = current
+		return
+	}
+
+	s.sent = current
+	if s.sent == nil {
+		s.sent = []DeviceDescriptor{}
+	}
+
+	// only the detector goroutine sends so there is room in the channel now:
+	s.events <- DeviceWatchEvent{
+		Events:  events,
+		Devices: current,
+	}
+}
+
+// diffDevices compares two device lists by URI.
+func diffDevices(before, after []DeviceDescriptor) (events []DeviceEvent) {
+	beforeByUri := make(map[string]*DeviceDescriptor, len(before))
+	for i := range before {
+		beforeByUri[before[i].Uri.String()] = &before[i]
+	}
+	afterByUri := make(map[string]struct{}, len(after))
+
+	for _, d := range after {
+		uri := d.Uri.String()
+		afterByUri[uri] = struct{}{}
+
+		b, ok := beforeByUri[uri]
+		if !ok {
+			events = append(events, DeviceEvent{Type: DeviceAdded, Descriptor: d})
+		} else if !reflect.DeepEqual(*b, d) {
+			events = append(events, DeviceEvent{Type: DeviceChanged, Descriptor: d})
+		}
+	}
+
+	for _, d := range before {
+		if _, ok := afterByUri[d.Uri.String()]; !ok {
+			events = append(events, DeviceEvent{Type: DeviceRemoved, Descriptor: d})
+		}
+	}
+
+	return
+}
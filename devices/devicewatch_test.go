@@ -0,0 +1,217 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDetectDriver is a Driver whose detected devices can be changed by the test:
+type fakeDetectDriver struct {
+	Driver
+
+	mu          sync.Mutex
+	descriptors []DeviceDescriptor
+	err         error
+	detects     int
+}
+
+func (d *fakeDetectDriver) Kind() string { return "fake" }
+
+func (d *fakeDetectDriver) Detect() ([]DeviceDescriptor, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.detects++
+	if d.err != nil {
+		return nil, d.err
+	}
+	return append([]DeviceDescriptor(nil), d.descriptors...), nil
+}
+
+func (d *fakeDetectDriver) fail(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.err = err
+}
+
+func (d *fakeDetectDriver) detectCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.detects
+}
+
+func (d *fakeDetectDriver) set(descriptors ...DeviceDescriptor) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.descriptors = descriptors
+}
+
+func fakeDescriptor(host, displayName string) DeviceDescriptor {
+	return DeviceDescriptor{
+		Uri:         url.URL{Scheme: "fake", Host: host},
+		DisplayName: displayName,
+		Kind:        "fake",
+	}
+}
+
+func nextDeviceWatchEvent(t *testing.T, events <-chan DeviceWatchEvent) DeviceWatchEvent {
+	t.Helper()
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed")
+		}
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for device event")
+	}
+	return DeviceWatchEvent{}
+}
+
+func TestWatchDevices(t *testing.T) {
+	interval := deviceDetectInterval
+	deviceDetectInterval = time.Millisecond * 10
+	driver := &fakeDetectDriver{}
+	Register("fake", driver)
+	t.Cleanup(func() {
+		deviceDetectInterval = interval
+		unregisterAllDrivers()
+	})
+
+	a := fakeDescriptor("a", "A")
+	b := fakeDescriptor("b", "B")
+	driver.set(a)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := WatchDevices(ctx)
+
+	// first event lists current devices as added:
+	ev := nextDeviceWatchEvent(t, events)
+	if len(ev.Events) != 1 || ev.Events[0].Type != DeviceAdded || ev.Events[0].Descriptor.DisplayName != "A" {
+		t.Fatalf("expected A added; got %+v", ev.Events)
+	}
+	if len(ev.Devices) != 1 {
+		t.Fatalf("expected 1 device; got %d", len(ev.Devices))
+	}
+
+	// b plugged in, a renamed:
+	a.DisplayName = "A2"
+	driver.set(a, b)
+	ev = nextDeviceWatchEvent(t, events)
+	if len(ev.Events) != 2 {
+		t.Fatalf("expected 2 events; got %+v", ev.Events)
+	}
+	if ev.Events[0].Type != DeviceChanged || ev.Events[0].Descriptor.DisplayName != "A2" {
+		t.Fatalf("expected A changed; got %+v", ev.Events[0])
+	}
+	if ev.Events[1].Type != DeviceAdded || ev.Events[1].Descriptor.DisplayName != "B" {
+		t.Fatalf("expected B added; got %+v", ev.Events[1])
+	}
+
+	// a unplugged:
+	driver.set(b)
+	ev = nextDeviceWatchEvent(t, events)
+	if len(ev.Events) != 1 || ev.Events[0].Type != DeviceRemoved || ev.Events[0].Descriptor.DisplayName != "A2" {
+		t.Fatalf("expected A removed; got %+v", ev.Events)
+	}
+	if len(ev.Devices) != 1 || ev.Devices[0].DisplayName != "B" {
+		t.Fatalf("expected only B; got %+v", ev.Devices)
+	}
+
+	// a late subscriber immediately gets the current devices:
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	ev = nextDeviceWatchEvent(t, WatchDevices(ctx2))
+	cancel2()
+	if len(ev.Events) != 1 || ev.Events[0].Type != DeviceAdded || ev.Events[0].Descriptor.DisplayName != "B" {
+		t.Fatalf("expected B added for late subscriber; got %+v", ev.Events)
+	}
+
+	// channel is closed once the subscriber goes away:
+	cancel()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("events channel not closed after context canceled")
+		}
+	}
+}
+
+func TestWatchDevices_MergesUnconsumedEvents(t *testing.T) {
+	a := fakeDescriptor("a", "A")
+	b := fakeDescriptor("b", "B")
+
+	s := &deviceSubscriber{ctx: context.Background(), events: make(chan DeviceWatchEvent, 1)}
+	s.post([]DeviceDescriptor{a})
+	<-s.events
+
+	// b added then a removed without the subscriber consuming in between:
+	s.post([]DeviceDescriptor{a, b})
+	s.post([]DeviceDescriptor{b})
+	ev := <-s.events
+	if len(ev.Events) != 2 || ev.Events[0].Type != DeviceAdded || ev.Events[1].Type != DeviceRemoved {
+		t.Fatalf("expected B added and A removed; got %+v", ev.Events)
+	}
+
+	// b added then removed again nets out to no event:
+	s.post([]DeviceDescriptor{b, a})
+	s.post([]DeviceDescriptor{b})
+	select {
+	case ev = <-s.events:
+		t.Fatalf("expected no event; got %+v", ev.Events)
+	default:
+	}
+}
+
+func TestObserveDevices(t *testing.T) {
+	interval := deviceDetectInterval
+	deviceDetectInterval = time.Millisecond * 10
+	driver := &fakeDetectDriver{}
+	Register("fake", driver)
+	t.Cleanup(func() {
+		deviceDetectInterval = interval
+		unregisterAllDrivers()
+	})
+
+	a := fakeDescriptor("a", "A")
+	driver.set(a)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := ObserveDevices(ctx)
+
+	// observing alone does not detect devices:
+	time.Sleep(time.Millisecond * 50)
+	if n := driver.detectCount(); n != 0 {
+		t.Fatalf("expected no detection while only observing; got %d", n)
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("expected no event before devices are detected; got %+v", ev)
+	default:
+	}
+
+	// an explicit detection is delivered:
+	if current := DetectDevices(); len(current) != 1 {
+		t.Fatalf("expected 1 device detected; got %+v", current)
+	}
+	ev := nextDeviceWatchEvent(t, events)
+	if len(ev.Events) != 1 || ev.Events[0].Type != DeviceAdded || ev.Events[0].Descriptor.DisplayName != "A" {
+		t.Fatalf("expected A added; got %+v", ev.Events)
+	}
+
+	// a driver failing to detect has its devices removed:
+	driver.fail(errors.New("detect failed"))
+	DetectDevices()
+	ev = nextDeviceWatchEvent(t, events)
+	if len(ev.Events) != 1 || ev.Events[0].Type != DeviceRemoved || len(ev.Devices) != 0 {
+		t.Fatalf("expected A removed; got %+v", ev)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"sort"
 	"sync"
+	"time"
 )
 
 var (
@@ -17,6 +18,19 @@ type NamedDriver struct {
 	Name   string
 }
 
+// Detect calls the driver's Detect and records how long detection took.
+func (n NamedDriver) Detect() (descriptors []DeviceDescriptor, err error) {
+	start := time.Now()
+	descriptors, err = n.Driver.Detect()
+	driverDetectSeconds.WithLabelValues(n.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		driverDetectErrors.WithLabelValues(n.Name).Inc()
+		return
+	}
+	driverDevices.WithLabelValues(n.Name).Set(float64(len(descriptors)))
+	return
+}
+
 // DriverDescriptor extends Driver
 type DriverDescriptor interface {
 	DisplayName() string
@@ -0,0 +1,89 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeMemoryDevice is a Device with 256 bytes of memory that ignores address spaces and mappings:
+type fakeMemoryDevice struct {
+	Device
+
+	mu     sync.Mutex
+	memory [0x100]byte
+	reads  int
+	// readDelay is slept on every MultiReadMemory call while not holding mu:
+	readDelay time.Duration
+}
+
+func (d *fakeMemoryDevice) IsClosed() bool { return false }
+
+func (d *fakeMemoryDevice) MultiReadMemory(_ context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	time.Sleep(d.readDelay)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.reads++
+	rsp = make([]MemoryReadResponse, 0, len(reads))
+	for _, read := range reads {
+		if int(read.RequestAddress.Address)+read.Size > len(d.memory) {
+			return nil, WithCode(codes.InvalidArgument, fmt.Errorf("read at %#x out of range", read.RequestAddress.Address))
+		}
+		data := make([]byte, read.Size)
+		copy(data, d.memory[read.RequestAddress.Address:])
+		rsp = append(rsp, MemoryReadResponse{
+			RequestAddress: read.RequestAddress,
+			DeviceAddress:  read.RequestAddress,
+			Data:           data,
+		})
+	}
+	return
+}
+
+func (d *fakeMemoryDevice) MultiWriteMemory(_ context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rsp = make([]MemoryWriteResponse, 0, len(writes))
+	for _, write := range writes {
+		copy(d.memory[write.RequestAddress.Address:], write.Data)
+		rsp = append(rsp, MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress:  write.RequestAddress,
+			Size:           len(write.Data),
+		})
+	}
+	return
+}
+
+func (d *fakeMemoryDevice) readCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.reads
+}
+
+func (d *fakeMemoryDevice) poke(addr int, value byte) {
+	d.mu.Lock()
+	d.memory[addr] = value
+	d.mu.Unlock()
+}
+
+var fakeDeviceCount atomic.Int64
+
+func newFakeMemoryDevice(t *testing.T) (*fakeMemoryDevice, AutoCloseableDevice) {
+	fake := &fakeMemoryDevice{}
+	container := NewDeviceDriverContainer(func(uri *url.URL) (Device, error) {
+		return fake, nil
+	})
+	// device state is shared by device key so make it unique per fake device:
+	deviceKey := fmt.Sprintf("%s-%d", t.Name(), fakeDeviceCount.Add(1))
+	uri := &url.URL{Scheme: "fake", Opaque: deviceKey}
+	return fake, NewAutoCloseableDevice(container, uri, deviceKey)
+}
@@ -24,6 +24,7 @@ type Driver interface {
 
 type DeviceDescriptor struct {
 	Uri                 url.URL
+	StableUri           url.URL // optional; identifies the same device as Uri across reconnects
 	DisplayName         string
 	Kind                string
 	Capabilities        []sni.DeviceCapability
@@ -64,6 +65,10 @@ type MemoryWriteRequest struct {
 	RequestAddress AddressTuple
 
 	Data []byte
+
+	// Expected, if not nil, makes the write conditional: Data is only written if the current contents of memory at
+	// RequestAddress are equal to Expected. Must be the same length as Data.
+	Expected []byte
 }
 
 type MemoryWriteResponse struct {
@@ -71,6 +76,16 @@ type MemoryWriteResponse struct {
 	DeviceAddress  AddressTuple
 
 	Size int
+
+	// CompareFailed is true if the write was conditional and the current contents of memory did not match the
+	// expected bytes; nothing was written and Size is 0.
+	CompareFailed bool
+}
+
+// DeviceCompareAndSwap is implemented by devices that can perform conditional writes natively. The compare and
+// write of each conditional write must be atomic with respect to all other access to the device.
+type DeviceCompareAndSwap interface {
+	MultiCompareAndSwapMemory(ctx context.Context, writes ...MemoryWriteRequest) ([]MemoryWriteResponse, error)
 }
 
 type DeviceControl interface {
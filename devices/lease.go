@@ -0,0 +1,189 @@
+package devices
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// MaxLeaseDuration is the longest time a single AcquireLease call can grant exclusive use of a device for.
+const MaxLeaseDuration = time.Minute * 5
+
+// deviceLease grants one client exclusive use of a device for a bounded time. While the lease is held, all device
+// calls whose context does not carry the lease token wait until the lease is released or expires.
+type deviceLease struct {
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+	// ended is closed when the current lease is released or replaced:
+	ended chan struct{}
+}
+
+type leaseTokenKey struct{}
+
+// WithLeaseToken returns a context that carries the given lease token so device calls made with it are allowed
+// through while that lease is held.
+func WithLeaseToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, leaseTokenKey{}, token)
+}
+
+// LeaseTokenFromContext returns the lease token carried by ctx, if any.
+func LeaseTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(leaseTokenKey{}).(string)
+	return token
+}
+
+// heldByOther returns the channel closed when the current lease is released and the time it expires if a lease
+// other than the one identified by token is currently held. Must be called with l.mu held.
+func (l *deviceLease) heldByOther(now time.Time, token string) (ended chan struct{}, expires time.Time, held bool) {
+	if l.token == "" || l.token == token || !now.Before(l.expires) {
+		return nil, time.Time{}, false
+	}
+	return l.ended, l.expires, true
+}
+
+// holder returns the token of the lease currently held, if any.
+func (l *deviceLease) holder(now time.Time) (token string, held bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.token == "" || !now.Before(l.expires) {
+		return "", false
+	}
+	return l.token, true
+}
+
+// waitLease blocks until the lease ends or ctx is done.
+func waitLease(ctx context.Context, ended chan struct{}, expires time.Time) error {
+	timer := time.NewTimer(time.Until(expires))
+	defer timer.Stop()
+
+	select {
+	case <-ended:
+	case <-timer.C:
+	case <-ctx.Done():
+		return WithCode(codes.Unavailable, fmt.Errorf("device is leased by another client until %s", expires.Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// await blocks until the device may be used by the caller, i.e. no lease is held or the lease token carried by ctx
+// matches the lease held. Fails with codes.Unavailable if ctx is done first.
+func (l *deviceLease) await(ctx context.Context) error {
+	token := LeaseTokenFromContext(ctx)
+	for {
+		l.mu.Lock()
+		ended, expires, held := l.heldByOther(time.Now(), token)
+		l.mu.Unlock()
+		if !held {
+			return nil
+		}
+
+		if err := waitLease(ctx, ended, expires); err != nil {
+			return err
+		}
+	}
+}
+
+// acquire grants a new lease or renews the lease identified by token for the given duration. If another lease is
+// held, acquire waits for it to end when wait is true and otherwise fails immediately with codes.Unavailable.
+func (l *deviceLease) acquire(ctx context.Context, token string, duration time.Duration, wait bool) (string, time.Time, error) {
+	if duration <= 0 || duration > MaxLeaseDuration {
+		return "", time.Time{}, WithCode(codes.InvalidArgument, fmt.Errorf("lease duration must be greater than 0 and at most %s", MaxLeaseDuration))
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		ended, expires, held := l.heldByOther(now, token)
+		if !held {
+			if token == "" || l.token != token || !now.Before(l.expires) {
+				// grant a new lease:
+				var err error
+				token, err = newLeaseToken()
+				if err != nil {
+					l.mu.Unlock()
+					return "", time.Time{}, err
+				}
+				if l.ended != nil {
+					close(l.ended)
+				}
+				l.token = token
+				l.ended = make(chan struct{})
+			}
+			l.expires = now.Add(duration)
+			expires = l.expires
+			l.mu.Unlock()
+			return token, expires, nil
+		}
+		l.mu.Unlock()
+
+		if !wait {
+			return "", time.Time{}, WithCode(codes.Unavailable, fmt.Errorf("device is leased by another client until %s", expires.Format(time.RFC3339)))
+		}
+		if err := waitLease(ctx, ended, expires); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+}
+
+// release ends the lease identified by token.
+func (l *deviceLease) release(token string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if token == "" || l.token != token || !time.Now().Before(l.expires) {
+		return WithCode(codes.FailedPrecondition, fmt.Errorf("lease token does not match the current lease"))
+	}
+
+	l.token = ""
+	l.expires = time.Time{}
+	close(l.ended)
+	l.ended = nil
+	return nil
+}
+
+func newLeaseToken() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// AcquireLease grants the caller exclusive use of the device for the given duration, or renews the lease identified
+// by token if it is still held. Device calls made with a context carrying the returned token (see WithLeaseToken)
+// are allowed through; all others wait until the lease is released or expires.
+func (a *autoCloseableDevice) AcquireLease(ctx context.Context, token string, duration time.Duration, wait bool) (newToken string, expires time.Time, err error) {
+	if a.logger != nil {
+		a.logger.Printf("AcquireLease(%#v, %#v) {\n", duration.String(), wait)
+	}
+	newToken, expires, err = a.state.lease.acquire(ctx, token, duration, wait)
+	if a.logger != nil {
+		a.logger.Printf("AcquireLease(%#v, %#v) } -> (%#v, %#v)\n", duration.String(), wait, expires.String(), err)
+	}
+	return
+}
+
+// ReleaseLease ends the lease identified by token before it expires.
+func (a *autoCloseableDevice) ReleaseLease(ctx context.Context, token string) (err error) {
+	if a.logger != nil {
+		a.logger.Printf("ReleaseLease() {\n")
+	}
+	err = a.state.lease.release(token)
+	if a.logger != nil {
+		a.logger.Printf("ReleaseLease() } -> (%#v)\n", err)
+	}
+	return
+}
+
+// Leased reports whether a lease is currently held on the device. Calls made without its token wait for it to end.
+func (a *autoCloseableDevice) Leased() bool {
+	_, held := a.state.lease.holder(time.Now())
+	return held
+}
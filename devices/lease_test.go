@@ -0,0 +1,160 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func isCode(err error, code codes.Code) bool {
+	var coded *CodedError
+	return errors.As(err, &coded) && coded.Code == code
+}
+
+func TestLease_ExcludesOtherClients(t *testing.T) {
+	_, device := newFakeMemoryDevice(t)
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 1}
+
+	token, _, err := device.AcquireLease(context.Background(), "", time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the holder may use the device:
+	holderCtx := WithLeaseToken(context.Background(), token)
+	if _, err = device.MultiReadMemory(holderCtx, read); err != nil {
+		t.Fatalf("lease holder read failed: %v", err)
+	}
+
+	if !device.Leased() {
+		t.Fatal("expected device to be leased")
+	}
+
+	// other clients time out:
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	_, err = device.MultiReadMemory(ctx, read)
+	cancel()
+	if !isCode(err, codes.Unavailable) {
+		t.Fatalf("expected Unavailable for read by other client; got %v", err)
+	}
+	_, _, err = device.AcquireLease(context.Background(), "", time.Minute, false)
+	if !isCode(err, codes.Unavailable) {
+		t.Fatalf("expected Unavailable for acquire by other client; got %v", err)
+	}
+
+	// other clients waiting are let through once the lease is released:
+	done := make(chan error, 1)
+	go func() {
+		_, err := device.MultiWriteMemory(context.Background(), MemoryWriteRequest{RequestAddress: read.RequestAddress, Data: []byte{1}})
+		done <- err
+	}()
+	select {
+	case err = <-done:
+		t.Fatalf("write by other client must wait for the lease to end; got %v", err)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	if err = device.ReleaseLease(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+	if device.Leased() {
+		t.Fatal("expected device not to be leased after release")
+	}
+	select {
+	case err = <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("write by other client not let through after lease released")
+	}
+}
+
+func TestLease_ExpiresAndRenews(t *testing.T) {
+	_, device := newFakeMemoryDevice(t)
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 1}
+
+	token, expires, err := device.AcquireLease(context.Background(), "", time.Millisecond*50, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renewed, renewedExpires, err := device.AcquireLease(context.Background(), token, time.Millisecond*100, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed != token {
+		t.Fatalf("renewing a held lease must keep its token")
+	}
+	if !renewedExpires.After(expires) {
+		t.Fatalf("renewing a held lease must extend it")
+	}
+
+	// another client waits for the lease to expire:
+	if _, err = device.MultiReadMemory(context.Background(), read); err != nil {
+		t.Fatal(err)
+	}
+	if time.Now().Before(renewedExpires) {
+		t.Fatalf("read by other client completed %s before the lease expired", time.Until(renewedExpires))
+	}
+
+	if err = device.ReleaseLease(context.Background(), token); !isCode(err, codes.FailedPrecondition) {
+		t.Fatalf("expected FailedPrecondition releasing an expired lease; got %v", err)
+	}
+}
+
+func TestLease_InvalidDuration(t *testing.T) {
+	_, device := newFakeMemoryDevice(t)
+
+	for _, d := range []time.Duration{0, MaxLeaseDuration + time.Second} {
+		if _, _, err := device.AcquireLease(context.Background(), "", d, false); !isCode(err, codes.InvalidArgument) {
+			t.Errorf("duration %s: expected InvalidArgument; got %v", d, err)
+		}
+	}
+}
+
+func TestLease_HolderWatchesMemory(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 1}
+
+	token, _, err := device.AcquireLease(context.Background(), "", time.Minute, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	otherEvents, err := device.WatchMemory(ctx, 0, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holderEvents, err := device.WatchMemory(WithLeaseToken(ctx, token), 0, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the holder's watch is polled during its lease:
+	nextEvent(t, holderEvents)
+	fake.poke(0x10, 0x42)
+	if ev := nextEvent(t, holderEvents); ev.Responses[0].Data[0] != 0x42 {
+		t.Fatalf("expected holder's watch to see the new value; got %v", ev.Responses[0].Data)
+	}
+
+	// other watches wait for the lease to end:
+	select {
+	case ev := <-otherEvents:
+		t.Fatalf("watch of other client must not be polled during the lease; got %+v", ev)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	if err = device.ReleaseLease(context.Background(), token); err != nil {
+		t.Fatal(err)
+	}
+	if ev := nextEvent(t, otherEvents); ev.Responses[0].Data[0] != 0x42 {
+		t.Fatalf("expected other watch to be polled after the lease; got %v", ev.Responses[0].Data)
+	}
+}
@@ -0,0 +1,277 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/timing"
+	"google.golang.org/grpc/codes"
+	"sni/util"
+	"time"
+)
+
+// MemoryWatchEvent is delivered to a WatchMemory subscriber whenever the contents of at least one of its
+// watched memory segments has changed.
+type MemoryWatchEvent struct {
+	// Responses holds the current contents of all watched segments in the order they were requested:
+	Responses []MemoryReadResponse
+	// Changed holds the indexes into Responses of the segments that changed since the previous event:
+	Changed []int
+	// Err is set when polling the device failed; the channel is closed after an error event:
+	Err error
+}
+
+// memoryPollTimeout bounds how long a single poll of the device may take:
+const memoryPollTimeout = time.Second * 5
+
+type memoryWatch struct {
+	ctx      context.Context
+	reads    []MemoryReadRequest
+	interval time.Duration
+	// leaseToken is the lease token carried by ctx when the watch was started:
+	leaseToken string
+
+	// only accessed from the poller goroutine:
+	nextPoll time.Time
+	last     [][]byte
+
+	// buffered with capacity 1; only the poller goroutine sends to and closes it:
+	events chan MemoryWatchEvent
+}
+
+// memoryPoller polls the device on behalf of all WatchMemory subscribers for that device, merging all due
+// subscriptions into a single MultiReadMemory call per frame.
+type memoryPoller struct {
+	state *deviceState
+	// memory is the device memory of the subscriber that started the poller:
+	memory DeviceMemory
+
+	// protected by state.mu:
+	watches []*memoryWatch
+}
+
+// WatchMemory polls the given memory segments at most once per interval (or once every frame if interval is
+// shorter than a frame) and sends an event on the returned channel whenever their contents change. The first
+// event contains all segments. If the subscriber falls behind, pending events are merged so only the latest
+// contents are delivered. The channel is closed once ctx is done or after an event with Err set is sent.
+func (a *autoCloseableDevice) WatchMemory(ctx context.Context, interval time.Duration, reads ...MemoryReadRequest) (events <-chan MemoryWatchEvent, err error) {
+	return a.watchMemory(ctx, interval, a, reads)
+}
+
+// watchMemory is WatchMemory polling through memory, which must refer to the same device as a.
+func (a *autoCloseableDevice) watchMemory(ctx context.Context, interval time.Duration, memory DeviceMemory, reads []MemoryReadRequest) (events <-chan MemoryWatchEvent, err error) {
+	if len(reads) == 0 {
+		err = WithCode(codes.InvalidArgument, fmt.Errorf("at least one memory segment must be watched"))
+		return
+	}
+	if interval < timing.Frame {
+		interval = timing.Frame
+	}
+
+	w := &memoryWatch{
+		ctx:        ctx,
+		reads:      reads,
+		interval:   interval,
+		leaseToken: LeaseTokenFromContext(ctx),
+		events:     make(chan MemoryWatchEvent, 1),
+	}
+	events = w.events
+
+	s := a.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.poller == nil {
+		s.poller = &memoryPoller{state: s, memory: memory}
+		go s.poller.run()
+	}
+	s.poller.watches = append(s.poller.watches, w)
+
+	return
+}
+
+func (p *memoryPoller) run() {
+	defer util.Recover()
+
+	ticker := time.NewTicker(timing.Frame)
+	defer ticker.Stop()
+
+	for {
+		due, ok := p.due(time.Now())
+		if !ok {
+			return
+		}
+		if len(due) > 0 {
+			p.poll(due)
+		}
+
+		<-ticker.C
+	}
+}
+
+// due removes watches whose subscribers have gone away and returns the watches due to be polled. ok is false
+// when no watches remain, in which case the poller is detached from the device state and must exit.
+func (p *memoryPoller) due(now time.Time) (due []*memoryWatch, ok bool) {
+	s := p.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watches := p.watches[:0]
+	for _, w := range p.watches {
+		if w.ctx.Err() != nil {
+			close(w.events)
+			continue
+		}
+
+		watches = append(watches, w)
+		if !now.Before(w.nextPoll) {
+			due = append(due, w)
+		}
+	}
+	for i := len(watches); i < len(p.watches); i++ {
+		p.watches[i] = nil
+	}
+	p.watches = watches
+
+	if len(p.watches) == 0 {
+		s.poller = nil
+		return nil, false
+	}
+
+	return due, true
+}
+
+func (p *memoryPoller) poll(due []*memoryWatch) {
+	// while a lease is held, only the lease holder's own watches are polled; the others stay due until it ends:
+	token, held := p.state.lease.holder(time.Now())
+	if held {
+		allowed := due[:0:0]
+		for _, w := range due {
+			if w.leaseToken == token {
+				allowed = append(allowed, w)
+			}
+		}
+		if due = allowed; len(due) == 0 {
+			return
+		}
+	}
+
+	n := 0
+	for _, w := range due {
+		n += len(w.reads)
+	}
+	reads := make([]MemoryReadRequest, 0, n)
+	for _, w := range due {
+		reads = append(reads, w.reads...)
+	}
+
+	ctx, cancel := context.WithTimeout(WithLeaseToken(context.Background(), token), memoryPollTimeout)
+	rsps, err := p.memory.MultiReadMemory(ctx, reads...)
+	cancel()
+	if err == nil && len(rsps) != len(reads) {
+		err = fmt.Errorf("memoryPoller: expected %d responses but got %d", len(reads), len(rsps))
+	}
+	if err != nil {
+		p.fail(due, err)
+		return
+	}
+
+	now := time.Now()
+	i := 0
+	for _, w := range due {
+		w.update(rsps[i : i+len(w.reads)])
+		w.nextPoll = now.Add(w.interval)
+		i += len(w.reads)
+	}
+}
+
+// fail removes the given watches and delivers err to their subscribers.
+func (p *memoryPoller) fail(failed []*memoryWatch, err error) {
+	s := p.state
+	s.mu.Lock()
+	watches := p.watches[:0]
+	for _, w := range p.watches {
+		isFailed := false
+		for _, f := range failed {
+			if w == f {
+				isFailed = true
+				break
+			}
+		}
+		if !isFailed {
+			watches = append(watches, w)
+		}
+	}
+	for i := len(watches); i < len(p.watches); i++ {
+		p.watches[i] = nil
+	}
+	p.watches = watches
+	s.mu.Unlock()
+
+	for _, w := range failed {
+		// the error replaces any event not yet consumed:
+		select {
+		case <-w.events:
+		default:
+		}
+		w.events <- MemoryWatchEvent{Err: err}
+		close(w.events)
+	}
+}
+
+func (w *memoryWatch) update(rsps []MemoryReadResponse) {
+	var changed []int
+	for j := range rsps {
+		if w.last == nil || !bytes.Equal(w.last[j], rsps[j].Data) {
+			changed = append(changed, j)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	if w.last == nil {
+		w.last = make([][]byte, len(rsps))
+	}
+	for _, j := range changed {
+		w.last[j] = append(w.last[j][:0], rsps[j].Data...)
+	}
+
+	w.post(MemoryWatchEvent{Responses: rsps, Changed: changed})
+}
+
+// post sends the event to the subscriber without blocking. If the subscriber has not yet consumed the previous
+// event, it is replaced with ev and the changed indexes of both are merged.
+func (w *memoryWatch) post(ev MemoryWatchEvent) {
+	select {
+	case w.events <- ev:
+		return
+	default:
+	}
+
+	select {
+	case prev := <-w.events:
+		ev.Changed = mergeChangedIndexes(len(w.reads), prev.Changed, ev.Changed)
+	default:
+	}
+
+	// only the poller goroutine sends so there is room in the channel now:
+	w.events <- ev
+}
+
+func mergeChangedIndexes(n int, a, b []int) []int {
+	set := make([]bool, n)
+	for _, j := range a {
+		set[j] = true
+	}
+	for _, j := range b {
+		set[j] = true
+	}
+
+	merged := make([]int, 0, len(a)+len(b))
+	for j, ok := range set {
+		if ok {
+			merged = append(merged, j)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,111 @@
+package devices
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func nextEvent(t *testing.T, events <-chan MemoryWatchEvent) MemoryWatchEvent {
+	t.Helper()
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("events channel closed unexpectedly")
+		}
+		if ev.Err != nil {
+			t.Fatalf("unexpected error: %v", ev.Err)
+		}
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return MemoryWatchEvent{}
+}
+
+func TestWatchMemory_ReportsChanges(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := device.WatchMemory(
+		ctx,
+		0,
+		MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 2},
+		MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x20}, Size: 2},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextEvent(t, events)
+	if !reflect.DeepEqual(ev.Changed, []int{0, 1}) {
+		t.Fatalf("first event must report all segments changed; got %v", ev.Changed)
+	}
+
+	fake.poke(0x21, 0x55)
+	ev = nextEvent(t, events)
+	if !reflect.DeepEqual(ev.Changed, []int{1}) {
+		t.Fatalf("expected only segment 1 changed; got %v", ev.Changed)
+	}
+	if ev.Responses[1].Data[1] != 0x55 {
+		t.Fatalf("expected new data in segment 1; got %v", ev.Responses[1].Data)
+	}
+
+	cancel()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("events channel not closed after context cancellation")
+		}
+	}
+}
+
+func TestWatchMemory_SharesPoller(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 1}
+	events1, err := device.WatchMemory(ctx, 0, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events2, err := device.WatchMemory(ctx, 0, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextEvent(t, events1)
+	nextEvent(t, events2)
+
+	fake.mu.Lock()
+	reads := fake.reads
+	fake.mu.Unlock()
+
+	time.Sleep(time.Millisecond * 100)
+
+	fake.mu.Lock()
+	delta := fake.reads - reads
+	fake.mu.Unlock()
+
+	// roughly 6 frames in 100ms; two independent pollers would make roughly twice as many reads:
+	if delta > 8 {
+		t.Fatalf("expected both subscriptions to share a single poller; got %d reads in 100ms", delta)
+	}
+}
+
+func TestMergeChangedIndexes(t *testing.T) {
+	got := mergeChangedIndexes(5, []int{3, 0}, []int{1, 3})
+	if !reflect.DeepEqual(got, []int{0, 1, 3}) {
+		t.Fatalf("got %v", got)
+	}
+}
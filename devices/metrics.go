@@ -0,0 +1,53 @@
+package devices
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// deviceBuckets are histogram upper bounds in seconds suited to device latencies, which are often well below the
+// smallest of prometheus.DefBuckets:
+var deviceBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+var (
+	deviceReadBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sni_device_read_bytes_total",
+		Help: "Bytes of memory read from the device.",
+	}, []string{"device"})
+	deviceWriteBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sni_device_write_bytes_total",
+		Help: "Bytes of memory written to the device.",
+	}, []string{"device"})
+	deviceOperationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sni_device_operation_duration_seconds",
+		Help:    "Time taken by device memory operations, including failed ones.",
+		Buckets: deviceBuckets,
+	}, []string{"device", "operation"})
+	deviceOperationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sni_device_operation_errors_total",
+		Help: "Device memory operations that failed.",
+	}, []string{"device", "operation"})
+
+	driverDetectSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sni_driver_detect_duration_seconds",
+		Help:    "Time taken by a driver to detect devices.",
+		Buckets: deviceBuckets,
+	}, []string{"driver"})
+	driverDetectErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sni_driver_detect_errors_total",
+		Help: "Device detections that failed.",
+	}, []string{"driver"})
+	driverDevices = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sni_driver_devices",
+		Help: "Number of devices found by the last detection of a driver.",
+	}, []string{"driver"})
+)
+
+func observeDeviceOperation(device string, operation string, start time.Time, err error) {
+	deviceOperationSeconds.WithLabelValues(device, operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		deviceOperationErrors.WithLabelValues(device, operation).Inc()
+	}
+}
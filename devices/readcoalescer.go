@@ -0,0 +1,371 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/protos/sni"
+	"sort"
+	"sync"
+	"time"
+)
+
+// readCoalescer merges reads issued concurrently against the same device into as few MultiReadMemory calls as
+// possible and serves repeated reads from a short-lived cache.
+//
+// Reads that arrive while another read is in flight are queued into a pending batch which is issued as a single
+// call once the in-flight read completes. Within a batch, overlapping or adjacent ranges are merged into a single
+// request when doing so cannot change what is read (see coalescable). If a batch of several callers fails, each of
+// them retries its own reads alone so that one caller's bad read does not fail the others.
+type readCoalescer struct {
+	mu sync.Mutex
+
+	// inflight is non-nil while a batch is being read from the device and is closed when that read completes:
+	inflight chan struct{}
+	// pending collects reads to be issued together once the in-flight read completes:
+	pending *readBatch
+
+	cache []cachedRead
+	// generation is incremented on every invalidation so that reads started before a write do not populate the cache:
+	generation uint64
+}
+
+type readBatch struct {
+	reads []MemoryReadRequest
+	// callers is the number of callers whose reads are in the batch:
+	callers int
+	started bool
+	done    chan struct{}
+
+	rsps []MemoryReadResponse
+	err  error
+}
+
+type cachedRead struct {
+	rsp MemoryReadResponse
+	at  time.Time
+}
+
+type readFunc func(ctx context.Context, reads ...MemoryReadRequest) ([]MemoryReadResponse, error)
+
+// readBatchTimeout bounds a batched read; the batch is issued without the context of any one caller since it may
+// contain reads of others, so a hung device would otherwise block every waiter forever:
+const readBatchTimeout = 30 * time.Second
+
+// pakRegionEnds are the ends of the regions of the FX Pak Pro address space that mapping.MemoryTypeForPakAddress
+// distinguishes: ROM, SRAM, unused, WRAM, VRAM, APU, CGRAM, OAM, MISC, PPUREG and CPUREG. Addresses from $100_0000 on
+// are the CMD space.
+var pakRegionEnds = []uint32{
+	0xE0_0000, 0xF0_0000, 0xF5_0000, 0xF7_0000, 0xF8_0000, 0xF9_0000,
+	0xF9_0200, 0xF9_0420, 0xF9_0500, 0xF9_0700, 0xF9_0900, 0x100_0000,
+}
+
+// bsxRegionEnds split the ROM region for BS-X carts into memory pack flash, PSRAM and BIOS as
+// mapping.MemoryTypeForBSXPakAddress does:
+var bsxRegionEnds = []uint32{0x10_0000, 0x40_0000, 0x48_0000, 0x80_0000, 0x90_0000}
+
+// pakRegion returns the index of the region of the FX Pak Pro address space containing the address, or -1 if it is
+// outside the address space.
+func pakRegion(address uint32, memoryMapping sni.MemoryMapping) int {
+	ends := pakRegionEnds
+	if memoryMapping == sni.MemoryMapping_BSX {
+		ends = append(bsxRegionEnds[:len(bsxRegionEnds):len(bsxRegionEnds)], pakRegionEnds...)
+	}
+	for i, end := range ends {
+		if address < end {
+			return i
+		}
+	}
+	return -1
+}
+
+// coalescable determines if a read may be merged with other reads and cached. Reads in the FX Pak Pro address space
+// may only be merged within one region of memory since regions are backed by different memories and some, like the
+// PPU registers, are snapshots rather than live memory. On the SNES A-bus only WRAM ($7E:0000-$7F:FFFF) is, since
+// other regions may be discontiguous depending on the memory mapping or contain I/O registers whose reads have side
+// effects. The layout of the raw address space is device specific so nothing there is coalesced.
+func coalescable(a AddressTuple, size int) bool {
+	switch a.AddressSpace {
+	case sni.AddressSpace_FxPakPro:
+		if size <= 0 {
+			return pakRegion(a.Address, a.MemoryMapping) >= 0
+		}
+		end := uint64(a.Address) + uint64(size) - 1
+		if end > 0xFFFF_FFFF {
+			return false
+		}
+		region := pakRegion(a.Address, a.MemoryMapping)
+		return region >= 0 && region == pakRegion(uint32(end), a.MemoryMapping)
+	case sni.AddressSpace_SnesABus:
+		return a.Address >= 0x7E0000 && uint64(a.Address)+uint64(size) <= 0x800000
+	default:
+		return false
+	}
+}
+
+func sameSpace(a, b AddressTuple) bool {
+	return a.AddressSpace == b.AddressSpace && a.MemoryMapping == b.MemoryMapping
+}
+
+// read returns the responses to the given reads either from the cache or by issuing them to the device with
+// readDevice, possibly merged with reads from other callers.
+func (c *readCoalescer) read(ctx context.Context, cacheFor time.Duration, reads []MemoryReadRequest, readDevice readFunc) (rsps []MemoryReadResponse, err error) {
+	c.mu.Lock()
+	if rsps = c.cached(time.Now(), cacheFor, reads); rsps != nil {
+		c.mu.Unlock()
+		return
+	}
+
+	if c.pending == nil {
+		c.pending = &readBatch{done: make(chan struct{})}
+	}
+	b := c.pending
+	offset := len(b.reads)
+	b.reads = append(b.reads, reads...)
+	b.callers++
+
+	for {
+		if b.started {
+			// another caller is issuing our batch:
+			c.mu.Unlock()
+			select {
+			case <-b.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			break
+		}
+
+		if c.inflight == nil {
+			// issue the batch ourselves:
+			b.started = true
+			c.pending = nil
+			c.inflight = make(chan struct{})
+			generation := c.generation
+			c.mu.Unlock()
+
+			// the batch may contain reads of other callers so it must not fail if our context is canceled:
+			bctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), readBatchTimeout)
+			var merged []MemoryReadResponse
+			b.rsps, merged, b.err = c.execute(bctx, b.reads, readDevice)
+			cancel()
+
+			c.mu.Lock()
+			if b.err == nil && cacheFor > 0 && c.generation == generation {
+				c.store(time.Now(), cacheFor, merged)
+			}
+			close(c.inflight)
+			c.inflight = nil
+			c.mu.Unlock()
+
+			close(b.done)
+			break
+		}
+
+		// wait for the in-flight batch to complete:
+		inflight := c.inflight
+		c.mu.Unlock()
+		select {
+		case <-inflight:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		c.mu.Lock()
+	}
+
+	if b.err != nil {
+		if b.callers == 1 {
+			return nil, b.err
+		}
+		// the batch may have failed due to a read of another caller so retry ours alone for our own result:
+		rsps, _, err = c.execute(ctx, reads, readDevice)
+		return
+	}
+
+	rsps = b.rsps[offset : offset+len(reads)]
+	return
+}
+
+// invalidate drops all cached reads; must be called after anything that may modify device memory.
+func (c *readCoalescer) invalidate() {
+	c.mu.Lock()
+	c.cache = nil
+	c.generation++
+	c.mu.Unlock()
+}
+
+// execute issues the merged reads to the device and splits the responses back out to the original reads.
+func (c *readCoalescer) execute(ctx context.Context, reads []MemoryReadRequest, readDevice readFunc) (rsps []MemoryReadResponse, merged []MemoryReadResponse, err error) {
+	mreads, slices := mergeReads(reads)
+
+	merged, err = readDevice(ctx, mreads...)
+	if err != nil {
+		return
+	}
+	if len(merged) != len(mreads) {
+		err = WithCode(codes.Internal, fmt.Errorf("merged read must have equal number of responses and requests; actual %d expected %d", len(merged), len(mreads)))
+		return
+	}
+
+	rsps = make([]MemoryReadResponse, len(reads))
+	for i, s := range slices {
+		m := merged[s.merged]
+		end := s.offset + reads[i].Size
+		if end > len(m.Data) {
+			end = len(m.Data)
+		}
+
+		deviceAddress := m.DeviceAddress
+		deviceAddress.Address += uint32(s.offset)
+		rsps[i] = MemoryReadResponse{
+			RequestAddress: reads[i].RequestAddress,
+			DeviceAddress:  deviceAddress,
+			Data:           m.Data[s.offset:end:end],
+		}
+	}
+
+	return
+}
+
+// cached returns responses for all reads if every one of them is satisfied by the cache, otherwise nil.
+func (c *readCoalescer) cached(now time.Time, cacheFor time.Duration, reads []MemoryReadRequest) []MemoryReadResponse {
+	if cacheFor <= 0 || len(c.cache) == 0 {
+		return nil
+	}
+
+	rsps := make([]MemoryReadResponse, 0, len(reads))
+	for _, read := range reads {
+		if !coalescable(read.RequestAddress, read.Size) {
+			return nil
+		}
+
+		found := false
+		for _, e := range c.cache {
+			if now.Sub(e.at) >= cacheFor {
+				continue
+			}
+			if !sameSpace(e.rsp.RequestAddress, read.RequestAddress) {
+				continue
+			}
+
+			start := e.rsp.RequestAddress.Address
+			end := uint64(start) + uint64(len(e.rsp.Data))
+			if read.RequestAddress.Address < start || uint64(read.RequestAddress.Address)+uint64(read.Size) > end {
+				continue
+			}
+
+			offset := read.RequestAddress.Address - start
+			deviceAddress := e.rsp.DeviceAddress
+			deviceAddress.Address += offset
+			data := make([]byte, read.Size)
+			copy(data, e.rsp.Data[offset:])
+
+			rsps = append(rsps, MemoryReadResponse{
+				RequestAddress: read.RequestAddress,
+				DeviceAddress:  deviceAddress,
+				Data:           data,
+			})
+			found = true
+			break
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	return rsps
+}
+
+func (c *readCoalescer) store(now time.Time, cacheFor time.Duration, merged []MemoryReadResponse) {
+	cache := c.cache[:0]
+	for _, e := range c.cache {
+		if now.Sub(e.at) < cacheFor {
+			cache = append(cache, e)
+		}
+	}
+	for _, m := range merged {
+		if !coalescable(m.RequestAddress, len(m.Data)) {
+			continue
+		}
+		cache = append(cache, cachedRead{rsp: m, at: now})
+	}
+	c.cache = cache
+}
+
+type readSlice struct {
+	merged int
+	offset int
+}
+
+// mergeReads merges overlapping and adjacent coalescable reads within the same address space and memory mapping.
+// Merged reads are ordered by the first original read they contain. slices maps each original read to the merged
+// read containing it and its offset within.
+func mergeReads(reads []MemoryReadRequest) (merged []MemoryReadRequest, slices []readSlice) {
+	slices = make([]readSlice, len(reads))
+
+	order := make([]int, len(reads))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		a, b := reads[order[x]].RequestAddress, reads[order[y]].RequestAddress
+		if a.AddressSpace != b.AddressSpace {
+			return a.AddressSpace < b.AddressSpace
+		}
+		if a.MemoryMapping != b.MemoryMapping {
+			return a.MemoryMapping < b.MemoryMapping
+		}
+		return a.Address < b.Address
+	})
+
+	type segment struct {
+		read  MemoryReadRequest
+		first int
+	}
+	segments := make([]segment, 0, len(reads))
+	segmentOf := make([]int, len(reads))
+
+	for _, i := range order {
+		r := reads[i]
+		if n := len(segments); n > 0 && coalescable(r.RequestAddress, r.Size) {
+			s := &segments[n-1]
+			start := s.read.RequestAddress.Address
+			end := uint64(start) + uint64(s.read.Size)
+			rend := max(end, uint64(r.RequestAddress.Address)+uint64(r.Size))
+			if sameSpace(s.read.RequestAddress, r.RequestAddress) &&
+				coalescable(s.read.RequestAddress, int(rend-uint64(start))) &&
+				uint64(r.RequestAddress.Address) <= end {
+				s.read.Size = int(rend - uint64(start))
+				if i < s.first {
+					s.first = i
+				}
+				segmentOf[i] = n - 1
+				slices[i].offset = int(r.RequestAddress.Address - start)
+				continue
+			}
+		}
+
+		segments = append(segments, segment{read: r, first: i})
+		segmentOf[i] = len(segments) - 1
+	}
+
+	// restore the original request order:
+	byFirst := make([]int, len(segments))
+	for j := range byFirst {
+		byFirst[j] = j
+	}
+	sort.Slice(byFirst, func(x, y int) bool {
+		return segments[byFirst[x]].first < segments[byFirst[y]].first
+	})
+	position := make([]int, len(segments))
+	merged = make([]MemoryReadRequest, len(segments))
+	for p, j := range byFirst {
+		position[j] = p
+		merged[p] = segments[j].read
+	}
+	for i := range reads {
+		slices[i].merged = position[segmentOf[i]]
+	}
+
+	return
+}
@@ -0,0 +1,233 @@
+package devices
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"reflect"
+	"sni/cmd/sni/config"
+	"sni/protos/sni"
+	"sync"
+	"testing"
+	"time"
+)
+
+func readAt(space sni.AddressSpace, address uint32, size int) MemoryReadRequest {
+	return MemoryReadRequest{
+		RequestAddress: AddressTuple{
+			Address:       address,
+			AddressSpace:  space,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: size,
+	}
+}
+
+func TestMergeReads(t *testing.T) {
+	const (
+		pak  = sni.AddressSpace_FxPakPro
+		abus = sni.AddressSpace_SnesABus
+	)
+
+	tests := []struct {
+		name       string
+		reads      []MemoryReadRequest
+		wantMerged []MemoryReadRequest
+		wantSlices []readSlice
+	}{
+		{
+			name:       "overlapping",
+			reads:      []MemoryReadRequest{readAt(pak, 0xF50010, 0x10), readAt(pak, 0xF50000, 0x18)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xF50000, 0x20)},
+			wantSlices: []readSlice{{0, 0x10}, {0, 0}},
+		},
+		{
+			name:       "adjacent",
+			reads:      []MemoryReadRequest{readAt(pak, 0xF50000, 0x10), readAt(pak, 0xF50010, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xF50000, 0x20)},
+			wantSlices: []readSlice{{0, 0}, {0, 0x10}},
+		},
+		{
+			name:       "contained",
+			reads:      []MemoryReadRequest{readAt(pak, 0xF50000, 0x100), readAt(pak, 0xF50020, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xF50000, 0x100)},
+			wantSlices: []readSlice{{0, 0}, {0, 0x20}},
+		},
+		{
+			name:       "disjoint keeps order",
+			reads:      []MemoryReadRequest{readAt(pak, 0xF60000, 0x10), readAt(pak, 0xF50000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xF60000, 0x10), readAt(pak, 0xF50000, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+		{
+			name:       "different spaces",
+			reads:      []MemoryReadRequest{readAt(pak, 0x7E0000, 0x10), readAt(abus, 0x7E0000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0x7E0000, 0x10), readAt(abus, 0x7E0000, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+		{
+			name:       "ROM and SRAM",
+			reads:      []MemoryReadRequest{readAt(pak, 0xDFFFF0, 0x10), readAt(pak, 0xE00000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xDFFFF0, 0x10), readAt(pak, 0xE00000, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+		{
+			name:       "WRAM and VRAM",
+			reads:      []MemoryReadRequest{readAt(pak, 0xF6FFF0, 0x20), readAt(pak, 0xF70000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(pak, 0xF6FFF0, 0x20), readAt(pak, 0xF70000, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+		{
+			name:       "raw",
+			reads:      []MemoryReadRequest{readAt(sni.AddressSpace_Raw, 0x0000, 0x10), readAt(sni.AddressSpace_Raw, 0x0010, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(sni.AddressSpace_Raw, 0x0000, 0x10), readAt(sni.AddressSpace_Raw, 0x0010, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+		{
+			name:       "abus WRAM",
+			reads:      []MemoryReadRequest{readAt(abus, 0x7EFFF0, 0x10), readAt(abus, 0x7F0000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(abus, 0x7EFFF0, 0x20)},
+			wantSlices: []readSlice{{0, 0}, {0, 0x10}},
+		},
+		{
+			name:       "abus non-WRAM",
+			reads:      []MemoryReadRequest{readAt(abus, 0x007FF0, 0x10), readAt(abus, 0x008000, 0x10)},
+			wantMerged: []MemoryReadRequest{readAt(abus, 0x007FF0, 0x10), readAt(abus, 0x008000, 0x10)},
+			wantSlices: []readSlice{{0, 0}, {1, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMerged, gotSlices := mergeReads(tt.reads)
+			if !reflect.DeepEqual(gotMerged, tt.wantMerged) {
+				t.Errorf("merged = %v, want %v", gotMerged, tt.wantMerged)
+			}
+			if !reflect.DeepEqual(gotSlices, tt.wantSlices) {
+				t.Errorf("slices = %v, want %v", gotSlices, tt.wantSlices)
+			}
+		})
+	}
+}
+
+func TestReadCoalescer_Cache(t *testing.T) {
+	previous := config.Config.Get("read_cache_frames")
+	config.Config.Set("read_cache_frames", 60)
+	t.Cleanup(func() { config.Config.Set("read_cache_frames", previous) })
+
+	fake, device := newFakeMemoryDevice(t)
+	fake.poke(0x12, 0x34)
+
+	ctx := context.Background()
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 4}
+	sub := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x12}, Size: 1}
+
+	if _, err := device.MultiReadMemory(ctx, read); err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := device.MultiReadMemory(ctx, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp[0].Data[0] != 0x34 {
+		t.Fatalf("cached read returned wrong data: %v", rsp[0].Data)
+	}
+	if rsp[0].DeviceAddress.Address != 0x12 {
+		t.Fatalf("cached read returned wrong device address: %#x", rsp[0].DeviceAddress.Address)
+	}
+	if n := fake.readCount(); n != 1 {
+		t.Fatalf("expected second read to be served from cache; got %d device reads", n)
+	}
+
+	// writes invalidate the cache:
+	_, err = device.MultiWriteMemory(ctx, MemoryWriteRequest{RequestAddress: sub.RequestAddress, Data: []byte{0x56}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp, err = device.MultiReadMemory(ctx, sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp[0].Data[0] != 0x56 {
+		t.Fatalf("read after write returned stale data: %v", rsp[0].Data)
+	}
+	if n := fake.readCount(); n != 2 {
+		t.Fatalf("expected read after write to go to the device; got %d device reads", n)
+	}
+}
+
+func TestReadCoalescer_Concurrent(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+	fake.readDelay = time.Millisecond * 50
+	for i := range fake.memory {
+		fake.memory[i] = byte(i)
+	}
+
+	const clients = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, clients)
+	for i := 0; i < clients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			read := MemoryReadRequest{RequestAddress: AddressTuple{Address: uint32(i * 8)}, Size: 16}
+			rsp, err := device.MultiReadMemory(context.Background(), read)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for j, b := range rsp[0].Data {
+				if b != byte(i*8+j) {
+					t.Errorf("client %d: data[%d] = %#x", i, j, b)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// the first read goes out alone and all others queue up behind it:
+	if n := fake.readCount(); n > 2 {
+		t.Fatalf("expected concurrent reads to be coalesced; got %d device reads", n)
+	}
+}
+
+func TestReadCoalescer_BatchErrorIsolated(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+	fake.readDelay = time.Millisecond * 50
+	fake.poke(0x10, 0x12)
+
+	// occupy the device so that the following reads queue up into one batch:
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = device.MultiReadMemory(context.Background(), MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x80}, Size: 1})
+	}()
+	time.Sleep(time.Millisecond * 10)
+
+	var goodRsp []MemoryReadResponse
+	var goodErr, badErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		goodRsp, goodErr = device.MultiReadMemory(context.Background(), MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 1})
+	}()
+	go func() {
+		defer wg.Done()
+		_, badErr = device.MultiReadMemory(context.Background(), MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x200}, Size: 1})
+	}()
+	wg.Wait()
+
+	if !isCode(badErr, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument for the bad read; got %v", badErr)
+	}
+	if goodErr != nil {
+		t.Fatalf("good read batched with a bad read failed: %v", goodErr)
+	}
+	if goodRsp[0].Data[0] != 0x12 {
+		t.Fatalf("good read returned wrong data: %v", goodRsp[0].Data)
+	}
+}
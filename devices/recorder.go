@@ -0,0 +1,440 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/protos/sni"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// RecordingVersion is the version of the recording file format written by the recorder.
+const RecordingVersion = 1
+
+// RecordingHeader is the first line of a recording file.
+type RecordingHeader struct {
+	Version int       `json:"version"`
+	Uri     string    `json:"uri"`
+	Started time.Time `json:"started"`
+}
+
+// RecordedCall is one line of a recording file after the header and describes a single call made to a Device.
+type RecordedCall struct {
+	// Time is when the call was made relative to RecordingHeader.Started:
+	Time time.Duration `json:"time"`
+	// Duration is how long the call took:
+	Duration time.Duration    `json:"duration"`
+	Op       string           `json:"op"`
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+
+	Err  string     `json:"err,omitempty"`
+	Code codes.Code `json:"code,omitempty"`
+}
+
+// RecordedRequest holds the arguments of a recorded call; only those used by its Op are set.
+type RecordedRequest struct {
+	Reads  []MemoryReadRequest  `json:"reads,omitempty"`
+	Writes []MemoryWriteRequest `json:"writes,omitempty"`
+
+	AddressSpace sni.AddressSpace `json:"addressSpace,omitempty"`
+	Address      *AddressTuple    `json:"address,omitempty"`
+
+	PausedState bool `json:"pausedState,omitempty"`
+
+	Path        string `json:"path,omitempty"`
+	NewFilename string `json:"newFilename,omitempty"`
+	Size        uint32 `json:"size,omitempty"`
+	Data        []byte `json:"data,omitempty"`
+
+	Fields []sni.Field `json:"fields,omitempty"`
+
+	Cmd       string `json:"cmd,omitempty"`
+	Args      string `json:"args,omitempty"`
+	BinaryArg []byte `json:"binaryArg,omitempty"`
+}
+
+// RecordedResponse holds the results of a recorded call; only those returned by its Op are set.
+type RecordedResponse struct {
+	Reads  []MemoryReadResponse  `json:"reads,omitempty"`
+	Writes []MemoryWriteResponse `json:"writes,omitempty"`
+
+	Bool bool `json:"bool,omitempty"`
+
+	Entries []DirEntry `json:"entries,omitempty"`
+	Size    uint32     `json:"size,omitempty"`
+	Data    []byte     `json:"data,omitempty"`
+
+	Values []string `json:"values,omitempty"`
+
+	AsciiReply  []map[string]string `json:"asciiReply,omitempty"`
+	BinaryReply []byte              `json:"binaryReply,omitempty"`
+}
+
+// Names of recorded operations:
+const (
+	OpRequiresMemoryMappingForAddressSpace = "RequiresMemoryMappingForAddressSpace"
+	OpRequiresMemoryMappingForAddress      = "RequiresMemoryMappingForAddress"
+	OpMultiReadMemory                      = "MultiReadMemory"
+	OpMultiWriteMemory                     = "MultiWriteMemory"
+	OpMultiCompareAndSwapMemory            = "MultiCompareAndSwapMemory"
+	OpResetSystem                          = "ResetSystem"
+	OpResetToMenu                          = "ResetToMenu"
+	OpPauseUnpause                         = "PauseUnpause"
+	OpPauseToggle                          = "PauseToggle"
+	OpReadDirectory                        = "ReadDirectory"
+	OpMakeDirectory                        = "MakeDirectory"
+	OpRemoveFile                           = "RemoveFile"
+	OpRenameFile                           = "RenameFile"
+	OpPutFile                              = "PutFile"
+	OpGetFile                              = "GetFile"
+	OpBootFile                             = "BootFile"
+	OpFetchFields                          = "FetchFields"
+	OpNWACommand                           = "NWACommand"
+)
+
+// Replayer is implemented by devices that replay recordings; these are never recorded themselves.
+type Replayer interface {
+	ReplayPath() string
+}
+
+// replaying reports whether the device replays a recording, opening it if necessary.
+func (a *autoCloseableDevice) replaying(ctx context.Context) (replaying bool) {
+	_ = a.useDevice(ctx, func(_ context.Context, device Device) error {
+		_, replaying = device.(Replayer)
+		return nil
+	})
+	return
+}
+
+// recording writes the calls made to one opened device to a recording file.
+type recording struct {
+	started time.Time
+
+	mu  sync.Mutex
+	w   io.WriteCloser
+	enc *json.Encoder
+}
+
+// newRecording writes the header of a recording of the device at uri to w. w is closed by close.
+func newRecording(uri *url.URL, w io.WriteCloser) (*recording, error) {
+	r := &recording{
+		started: time.Now(),
+		w:       w,
+		enc:     json.NewEncoder(w),
+	}
+
+	err := r.enc.Encode(&RecordingHeader{
+		Version: RecordingVersion,
+		Uri:     uri.String(),
+		Started: r.started,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// RecordingDir returns the directory recordings are written to.
+func RecordingDir() string {
+	dir := config.Config.GetString("record_dir")
+	if dir == "" {
+		dir = filepath.Join(config.Dir, "recordings")
+	}
+	return dir
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// startRecording starts a new recording of a newly opened device if recording is enabled, ending any previous
+// recording of it. Failing to start recording is logged and the device is used without recording.
+func (s *deviceState) startRecording(deviceKey string, uri *url.URL, device Device) {
+	s.stopRecording()
+
+	if !config.Config.GetBool("record_enable") {
+		return
+	}
+	if _, ok := device.(Replayer); ok {
+		return
+	}
+
+	dir := RecordingDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Printf("record: %v\n", err)
+		return
+	}
+
+	name := fmt.Sprintf(
+		"%s-%s-%s.jsonl",
+		uri.Scheme,
+		unsafeFilenameChars.ReplaceAllString(deviceKey, "_"),
+		time.Now().Format("20060102-150405"),
+	)
+	path := filepath.Join(dir, name)
+
+	f, err := os.Create(path)
+	if err != nil {
+		log.Printf("record: %v\n", err)
+		return
+	}
+
+	rec, err := newRecording(uri, f)
+	if err != nil {
+		_ = f.Close()
+		log.Printf("record: %v\n", err)
+		return
+	}
+
+	log.Printf("record: recording device '%s' to '%s'\n", uri, path)
+	s.mu.Lock()
+	s.recording = rec
+	s.mu.Unlock()
+}
+
+// stopRecording ends the recording of the device, if any, when it is closed.
+func (s *deviceState) stopRecording() {
+	s.mu.Lock()
+	rec := s.recording
+	s.recording = nil
+	s.mu.Unlock()
+
+	if rec != nil {
+		rec.close()
+	}
+}
+
+func (s *deviceState) currentRecording() *recording {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.recording
+}
+
+// ReadRecording reads a recording file written by a recording device.
+func ReadRecording(rd io.Reader) (header RecordingHeader, calls []RecordedCall, err error) {
+	dec := json.NewDecoder(rd)
+	if err = dec.Decode(&header); err != nil {
+		return header, nil, fmt.Errorf("recording: header: %w", err)
+	}
+	if header.Version != RecordingVersion {
+		return header, nil, fmt.Errorf("recording: unsupported version %d", header.Version)
+	}
+
+	for {
+		var call RecordedCall
+		if err = dec.Decode(&call); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+				return
+			}
+			// a recording cut short by a crash still replays up to its last complete call:
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				log.Printf("recording: truncated after %d calls\n", len(calls))
+				err = nil
+				return
+			}
+			return header, nil, fmt.Errorf("recording: call %d: %w", len(calls), err)
+		}
+		calls = append(calls, call)
+	}
+}
+
+func (r *recording) record(op string, start time.Time, req RecordedRequest, rsp RecordedResponse, err error) {
+	call := RecordedCall{
+		Time:     start.Sub(r.started),
+		Duration: time.Since(start),
+		Op:       op,
+		Request:  req,
+		Response: rsp,
+	}
+	if err != nil {
+		call.Err = err.Error()
+		var coded *CodedError
+		if errors.As(err, &coded) {
+			call.Code = coded.Code
+		} else {
+			call.Code = codes.Unknown
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.enc == nil {
+		return
+	}
+	if werr := r.enc.Encode(&call); werr != nil {
+		log.Printf("record: %v; recording stopped\n", werr)
+		_ = r.w.Close()
+		r.enc = nil
+	}
+}
+
+func (r *recording) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.enc != nil {
+		if cerr := r.w.Close(); cerr != nil {
+			log.Printf("record: %v\n", cerr)
+		}
+		r.enc = nil
+	}
+}
+
+// recordingDevice records every call made through an AutoCloseableDevice, i.e. the calls exactly as clients make
+// them before reads are merged or served from the read cache, to the current recording of the device it refers to.
+// This is the layer replayed reads arrive at since devices replaying a recording bypass merging and the cache.
+// Calls made while the device is not opened or not being recorded are not recorded.
+type recordingDevice struct {
+	AutoCloseableDevice
+	device *autoCloseableDevice
+}
+
+func (r *recordingDevice) record(op string, start time.Time, req RecordedRequest, rsp RecordedResponse, err error) {
+	if rec := r.device.state.currentRecording(); rec != nil {
+		rec.record(op, start, req, rsp, err)
+	}
+}
+
+func (r *recordingDevice) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (ok bool, err error) {
+	start := time.Now()
+	ok, err = r.AutoCloseableDevice.RequiresMemoryMappingForAddressSpace(ctx, addressSpace)
+	r.record(OpRequiresMemoryMappingForAddressSpace, start, RecordedRequest{AddressSpace: addressSpace}, RecordedResponse{Bool: ok}, err)
+	return
+}
+
+func (r *recordingDevice) RequiresMemoryMappingForAddress(ctx context.Context, address AddressTuple) (ok bool, err error) {
+	start := time.Now()
+	ok, err = r.AutoCloseableDevice.RequiresMemoryMappingForAddress(ctx, address)
+	r.record(OpRequiresMemoryMappingForAddress, start, RecordedRequest{Address: &address}, RecordedResponse{Bool: ok}, err)
+	return
+}
+
+func (r *recordingDevice) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	start := time.Now()
+	rsp, err = r.AutoCloseableDevice.MultiReadMemory(ctx, reads...)
+	r.record(OpMultiReadMemory, start, RecordedRequest{Reads: reads}, RecordedResponse{Reads: rsp}, err)
+	return
+}
+
+// MultiWriteMemory records conditional writes as one MultiCompareAndSwapMemory call whether the device performs them
+// natively or not.
+func (r *recordingDevice) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	start := time.Now()
+	rsp, err = r.AutoCloseableDevice.MultiWriteMemory(ctx, writes...)
+	op := OpMultiWriteMemory
+	if conditional, _ := hasConditionalWrites(writes); conditional {
+		op = OpMultiCompareAndSwapMemory
+	}
+	r.record(op, start, RecordedRequest{Writes: writes}, RecordedResponse{Writes: rsp}, err)
+	return
+}
+
+// WatchMemory polls through the recording device so that the reads of the memory poller are recorded too.
+func (r *recordingDevice) WatchMemory(ctx context.Context, interval time.Duration, reads ...MemoryReadRequest) (<-chan MemoryWatchEvent, error) {
+	return r.device.watchMemory(ctx, interval, r, reads)
+}
+
+func (r *recordingDevice) ResetSystem(ctx context.Context) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.ResetSystem(ctx)
+	r.record(OpResetSystem, start, RecordedRequest{}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) ResetToMenu(ctx context.Context) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.ResetToMenu(ctx)
+	r.record(OpResetToMenu, start, RecordedRequest{}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) PauseUnpause(ctx context.Context, pausedState bool) (ok bool, err error) {
+	start := time.Now()
+	ok, err = r.AutoCloseableDevice.PauseUnpause(ctx, pausedState)
+	r.record(OpPauseUnpause, start, RecordedRequest{PausedState: pausedState}, RecordedResponse{Bool: ok}, err)
+	return
+}
+
+func (r *recordingDevice) PauseToggle(ctx context.Context) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.PauseToggle(ctx)
+	r.record(OpPauseToggle, start, RecordedRequest{}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) ReadDirectory(ctx context.Context, path string) (entries []DirEntry, err error) {
+	start := time.Now()
+	entries, err = r.AutoCloseableDevice.ReadDirectory(ctx, path)
+	r.record(OpReadDirectory, start, RecordedRequest{Path: path}, RecordedResponse{Entries: entries}, err)
+	return
+}
+
+func (r *recordingDevice) MakeDirectory(ctx context.Context, path string) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.MakeDirectory(ctx, path)
+	r.record(OpMakeDirectory, start, RecordedRequest{Path: path}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) RemoveFile(ctx context.Context, path string) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.RemoveFile(ctx, path)
+	r.record(OpRemoveFile, start, RecordedRequest{Path: path}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) RenameFile(ctx context.Context, path, newFilename string) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.RenameFile(ctx, path, newFilename)
+	r.record(OpRenameFile, start, RecordedRequest{Path: path, NewFilename: newFilename}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) PutFile(ctx context.Context, path string, size uint32, rd io.Reader, progress ProgressReportFunc) (n uint32, err error) {
+	start := time.Now()
+	var data bytes.Buffer
+	n, err = r.AutoCloseableDevice.PutFile(ctx, path, size, io.TeeReader(rd, &data), progress)
+	r.record(OpPutFile, start, RecordedRequest{Path: path, Size: size, Data: data.Bytes()}, RecordedResponse{Size: n}, err)
+	return
+}
+
+func (r *recordingDevice) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived SizeReceivedFunc, progress ProgressReportFunc) (size uint32, err error) {
+	start := time.Now()
+	var data bytes.Buffer
+	size, err = r.AutoCloseableDevice.GetFile(ctx, path, io.MultiWriter(w, &data), sizeReceived, progress)
+	r.record(OpGetFile, start, RecordedRequest{Path: path}, RecordedResponse{Size: size, Data: data.Bytes()}, err)
+	return
+}
+
+func (r *recordingDevice) BootFile(ctx context.Context, path string) (err error) {
+	start := time.Now()
+	err = r.AutoCloseableDevice.BootFile(ctx, path)
+	r.record(OpBootFile, start, RecordedRequest{Path: path}, RecordedResponse{}, err)
+	return
+}
+
+func (r *recordingDevice) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
+	start := time.Now()
+	values, err = r.AutoCloseableDevice.FetchFields(ctx, fields...)
+	r.record(OpFetchFields, start, RecordedRequest{Fields: fields}, RecordedResponse{Values: values}, err)
+	return
+}
+
+func (r *recordingDevice) NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error) {
+	start := time.Now()
+	asciiReply, binaryReply, err = r.AutoCloseableDevice.NWACommand(ctx, cmd, args, binaryArg)
+	r.record(OpNWACommand, start, RecordedRequest{Cmd: cmd, Args: args, BinaryArg: binaryArg}, RecordedResponse{AsciiReply: asciiReply, BinaryReply: binaryReply}, err)
+	return
+}
@@ -0,0 +1,67 @@
+package devices
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"testing"
+)
+
+func TestRecordingDevice_RecordsClientCalls(t *testing.T) {
+	dir := t.TempDir()
+	previous := config.Config.Get("read_cache_frames")
+	config.Config.Set("read_cache_frames", 60)
+	config.Config.Set("record_enable", true)
+	config.Config.Set("record_dir", dir)
+	t.Cleanup(func() {
+		config.Config.Set("read_cache_frames", previous)
+		config.Config.Set("record_enable", false)
+		config.Config.Set("record_dir", "")
+	})
+
+	fake, device := newFakeMemoryDevice(t)
+	t.Cleanup(deviceStateFor(device.URI(), device.DeviceKey()).stopRecording)
+	fake.poke(0x12, 0x34)
+
+	ctx := context.Background()
+	read := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x10}, Size: 4}
+	sub := MemoryReadRequest{RequestAddress: AddressTuple{Address: 0x12}, Size: 1}
+	if _, err := device.MultiReadMemory(ctx, read); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := device.MultiReadMemory(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	if n := fake.readCount(); n != 1 {
+		t.Fatalf("expected second read to be served from cache; got %d device reads", n)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "fake-*.jsonl"))
+	if err != nil || len(paths) != 1 {
+		t.Fatalf("recordings = %v, %v; expected one", paths, err)
+	}
+	f, err := os.Open(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	_, calls, err := ReadRecording(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// both reads are recorded as the client made them, including the one served from the cache:
+	if len(calls) != 2 {
+		t.Fatalf("recorded %d calls, expected 2", len(calls))
+	}
+	for i, want := range []MemoryReadRequest{read, sub} {
+		call := calls[i]
+		if call.Op != OpMultiReadMemory || len(call.Request.Reads) != 1 || call.Request.Reads[0] != want {
+			t.Errorf("call %d = %s %+v, expected read of %+v", i, call.Op, call.Request.Reads, want)
+		}
+	}
+	if data := calls[1].Response.Reads[0].Data; len(data) != 1 || data[0] != 0x34 {
+		t.Errorf("recorded cached read = %v, expected [0x34]", data)
+	}
+}
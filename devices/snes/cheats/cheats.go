@@ -0,0 +1,390 @@
+package cheats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/timing"
+	"google.golang.org/grpc/codes"
+	"log"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"sni/util"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Cheat is a code added to a device.
+type Cheat struct {
+	Code
+	ID   string
+	Name string
+
+	// PakAddress is the address of the code in the FX Pak Pro address space and MemoryType is the memory it falls in;
+	// codes in ROM are patched once when enabled while codes in RAM are frozen by rewriting them whenever they change:
+	PakAddress    uint32
+	MemoryType    mapping.MemoryType
+	MemoryMapping sni.MemoryMapping
+	Enabled       bool
+
+	// original is the ROM byte replaced by an enabled ROM patch:
+	original uint8
+}
+
+// IsRomPatch reports whether the cheat patches ROM rather than freezing RAM.
+func (c *Cheat) IsRomPatch() bool {
+	return c.MemoryType == mapping.MemoryTypeROM
+}
+
+func (c *Cheat) address() devices.AddressTuple {
+	return devices.AddressTuple{
+		Address:       c.PakAddress,
+		AddressSpace:  sni.AddressSpace_FxPakPro,
+		MemoryMapping: c.MemoryMapping,
+	}
+}
+
+// freezeTimeout bounds how long checking and writing the frozen values may take, including waiting out a lease
+// acquired during the attempt:
+const freezeTimeout = time.Second
+
+// freezeGiveUp is how long freezing may keep failing before it is stopped and the RAM cheats disabled:
+const freezeGiveUp = time.Second * 10
+
+type deviceCheats struct {
+	uri string
+
+	mu       sync.Mutex
+	cheats   []*Cheat
+	nextID   int
+	freezing bool
+}
+
+var (
+	devicesMu sync.Mutex
+	byUri     = make(map[string]*deviceCheats)
+)
+
+func cheatsFor(uri string) *deviceCheats {
+	devicesMu.Lock()
+	defer devicesMu.Unlock()
+
+	d, ok := byUri[uri]
+	if !ok {
+		d = &deviceCheats{uri: uri}
+		byUri[uri] = d
+	}
+	return d
+}
+
+// Add decodes the code and adds it to the cheats of the device, enabling it if requested. If memoryMapping is
+// Unknown, it is detected from the ROM header.
+func Add(ctx context.Context, memory devices.DeviceMemory, uri string, code string, name string, memoryMapping sni.MemoryMapping, enabled bool) (c Cheat, err error) {
+	var decoded Code
+	if decoded, err = Decode(code); err != nil {
+		return
+	}
+
+	if memoryMapping == sni.MemoryMapping_Unknown {
+		if memoryMapping, _, _, err = mapping.Detect(ctx, memory, nil, nil); err != nil {
+			return
+		}
+	}
+
+	var pakAddress uint32
+	pakAddress, err = mapping.TranslateAddress(
+		devices.AddressTuple{
+			Address:       decoded.Address,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: memoryMapping,
+		},
+		sni.AddressSpace_FxPakPro,
+	)
+	if err != nil {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("cheats: cannot map code %s address $%06x: %w", decoded, decoded.Address, err))
+		return
+	}
+
+	memoryType, _ := mapping.MemoryTypeForPakAddress(pakAddress)
+	if !memoryType.IsOnBus() {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("cheats: code %s address $%06x is not in ROM, SRAM or WRAM", decoded, decoded.Address))
+		return
+	}
+
+	d := cheatsFor(uri)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID++
+	cheat := &Cheat{
+		Code:          decoded,
+		ID:            strconv.Itoa(d.nextID),
+		Name:          name,
+		PakAddress:    pakAddress,
+		MemoryType:    memoryType,
+		MemoryMapping: memoryMapping,
+	}
+	if enabled {
+		if err = d.enable(ctx, memory, cheat); err != nil {
+			return
+		}
+	}
+	d.cheats = append(d.cheats, cheat)
+
+	c = *cheat
+	return
+}
+
+// List returns the cheats added to the device in the order they were added.
+func List(uri string) (cheats []Cheat) {
+	d := cheatsFor(uri)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cheats = make([]Cheat, 0, len(d.cheats))
+	for _, cheat := range d.cheats {
+		cheats = append(cheats, *cheat)
+	}
+	return
+}
+
+// SetEnabled enables or disables a cheat of the device. Disabling a ROM patch restores the original ROM byte;
+// disabling a RAM cheat stops freezing it and leaves memory as it is.
+func SetEnabled(ctx context.Context, memory devices.DeviceMemory, uri string, id string, enabled bool) (c Cheat, err error) {
+	d := cheatsFor(uri)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var cheat *Cheat
+	if cheat, _, err = d.find(id); err != nil {
+		return
+	}
+
+	if enabled {
+		err = d.enable(ctx, memory, cheat)
+	} else {
+		err = d.disable(ctx, memory, cheat)
+	}
+	if err != nil {
+		return
+	}
+
+	c = *cheat
+	return
+}
+
+// Remove disables and removes a cheat of the device.
+func Remove(ctx context.Context, memory devices.DeviceMemory, uri string, id string) (err error) {
+	d := cheatsFor(uri)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var cheat *Cheat
+	var i int
+	if cheat, i, err = d.find(id); err != nil {
+		return
+	}
+	if err = d.disable(ctx, memory, cheat); err != nil {
+		return
+	}
+
+	d.cheats = append(d.cheats[:i], d.cheats[i+1:]...)
+	return
+}
+
+func (d *deviceCheats) find(id string) (*Cheat, int, error) {
+	for i, cheat := range d.cheats {
+		if cheat.ID == id {
+			return cheat, i, nil
+		}
+	}
+	return nil, -1, devices.WithCode(codes.NotFound, fmt.Errorf("cheats: no cheat with id '%s' on device", id))
+}
+
+func (d *deviceCheats) enable(ctx context.Context, memory devices.DeviceMemory, cheat *Cheat) (err error) {
+	if cheat.Enabled {
+		return
+	}
+
+	if cheat.IsRomPatch() {
+		var rsps []devices.MemoryReadResponse
+		rsps, err = memory.MultiReadMemory(ctx, devices.MemoryReadRequest{RequestAddress: cheat.address(), Size: 1})
+		if err != nil {
+			return
+		}
+		cheat.original = rsps[0].Data[0]
+
+		if err = writeByte(ctx, memory, cheat, cheat.Value); err != nil {
+			return
+		}
+	} else {
+		// write once now so the cheat takes effect even before the freezer starts:
+		if err = writeByte(ctx, memory, cheat, cheat.Value); err != nil {
+			return
+		}
+		if !d.freezing {
+			d.freezing = true
+			go d.freeze(freezeInterval())
+		}
+	}
+
+	cheat.Enabled = true
+	return
+}
+
+func (d *deviceCheats) disable(ctx context.Context, memory devices.DeviceMemory, cheat *Cheat) (err error) {
+	if !cheat.Enabled {
+		return
+	}
+
+	if cheat.IsRomPatch() {
+		if err = writeByte(ctx, memory, cheat, cheat.original); err != nil {
+			return
+		}
+	}
+
+	cheat.Enabled = false
+	return
+}
+
+func writeByte(ctx context.Context, memory devices.DeviceMemory, cheat *Cheat, value uint8) (err error) {
+	_, err = memory.MultiWriteMemory(ctx, devices.MemoryWriteRequest{
+		RequestAddress: cheat.address(),
+		Data:           []byte{value},
+	})
+	return
+}
+
+// frozen returns the writes for all enabled RAM cheats. ok is false when there are none, in which case the freezer
+// is stopped and must exit.
+func (d *deviceCheats) frozen() (writes []devices.MemoryWriteRequest, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, cheat := range d.cheats {
+		if cheat.Enabled && !cheat.IsRomPatch() {
+			writes = append(writes, devices.MemoryWriteRequest{
+				RequestAddress: cheat.address(),
+				Data:           []byte{cheat.Value},
+			})
+		}
+	}
+	if len(writes) == 0 {
+		d.freezing = false
+		return nil, false
+	}
+	return writes, true
+}
+
+// stopFreezing disables all RAM cheats after the freezer failed for good; it must exit afterwards.
+func (d *deviceCheats) stopFreezing() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, cheat := range d.cheats {
+		if !cheat.IsRomPatch() {
+			cheat.Enabled = false
+		}
+	}
+	d.freezing = false
+}
+
+// freezeInterval is how often frozen values are checked:
+func freezeInterval() time.Duration {
+	return time.Duration(max(config.Config.GetInt("cheats_freeze_frames"), 1)) * timing.Frame
+}
+
+// freezeOnce reads the frozen addresses and writes back only the values that changed. n is the number of values
+// written.
+func freezeOnce(ctx context.Context, memory devices.DeviceMemory, writes []devices.MemoryWriteRequest) (n int, err error) {
+	reads := make([]devices.MemoryReadRequest, 0, len(writes))
+	for _, write := range writes {
+		reads = append(reads, devices.MemoryReadRequest{RequestAddress: write.RequestAddress, Size: len(write.Data)})
+	}
+
+	var rsps []devices.MemoryReadResponse
+	if rsps, err = memory.MultiReadMemory(ctx, reads...); err != nil {
+		return
+	}
+
+	changed := writes[:0:0]
+	for i, write := range writes {
+		if !bytes.Equal(rsps[i].Data, write.Data) {
+			changed = append(changed, write)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	if _, err = memory.MultiWriteMemory(ctx, changed...); err != nil {
+		return
+	}
+	n = len(changed)
+	return
+}
+
+// freeze keeps the values of all enabled RAM cheats in place, checking them every interval, until none remain
+// enabled. It pauses while another client holds a lease on the device, and stops and disables the RAM cheats if the
+// device can no longer be found or keeps failing for freezeGiveUp.
+func (d *deviceCheats) freeze(interval time.Duration) {
+	defer util.Recover()
+
+	uri, err := url.Parse(d.uri)
+	if err != nil {
+		log.Printf("cheats: %s: %v\n", d.uri, err)
+		d.stopFreezing()
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastErr string
+	var failingSince time.Time
+	for range ticker.C {
+		writes, ok := d.frozen()
+		if !ok {
+			return
+		}
+
+		var device devices.AutoCloseableDevice
+		if _, device, err = devices.DeviceByUri(uri); err != nil {
+			log.Printf("cheats: %s: freeze stopped: %v\n", d.uri, err)
+			d.stopFreezing()
+			return
+		}
+
+		// the freezer holds no lease token so its writes would only wait out the lease:
+		if device.Leased() {
+			lastErr, failingSince = "", time.Time{}
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), freezeTimeout)
+		_, err = freezeOnce(ctx, device, writes)
+		cancel()
+		if err == nil || device.Leased() {
+			// a lease acquired during the attempt pauses freezing rather than failing it:
+			lastErr, failingSince = "", time.Time{}
+			continue
+		}
+
+		// log each distinct failure once so a disconnected device does not flood the log:
+		if err.Error() != lastErr {
+			log.Printf("cheats: %s: freeze: %v\n", d.uri, err)
+		}
+		lastErr = err.Error()
+		if failingSince.IsZero() {
+			failingSince = time.Now()
+		} else if time.Since(failingSince) >= freezeGiveUp {
+			log.Printf("cheats: %s: freeze stopped after failing for %v\n", d.uri, freezeGiveUp)
+			d.stopFreezing()
+			return
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package cheats
+
+import (
+	"context"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestDecode(t *testing.T) {
+	for _, tc := range []struct {
+		code     string
+		expected Code
+	}{
+		{"3C64-0DAD", Code{GameGenie, 0x00_8123, 0xEA}},
+		{"ddee-37d7", Code{GameGenie, 0x80_FFFC, 0x00}},
+		{"DBD8-9E88", Code{GameGenie, 0x7E_0DBE, 0x09}},
+		{"7E0DBE09", Code{ProActionReplay, 0x7E_0DBE, 0x09}},
+		{" 7e0dbe:09 ", Code{ProActionReplay, 0x7E_0DBE, 0x09}},
+	} {
+		c, err := Decode(tc.code)
+		if err != nil {
+			t.Errorf("Decode(%q): %v", tc.code, err)
+			continue
+		}
+		if c != tc.expected {
+			t.Errorf("Decode(%q) = %+v, expected %+v", tc.code, c, tc.expected)
+		}
+	}
+
+	for _, code := range []string{"", "3C64-0DAG", "7E0DBE0", "7E0D:BE09", "7E0DBEXX", "3C640DAD-"} {
+		if _, err := Decode(code); !snestest.IsCode(err, codes.InvalidArgument) {
+			t.Errorf("Decode(%q): expected InvalidArgument but got %v", code, err)
+		}
+	}
+}
+
+func TestCodeString(t *testing.T) {
+	for _, code := range []string{"3C64-0DAD", "DDEE-37D7", "DBD8-9E88", "7E0DBE09"} {
+		c, err := Decode(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.String(); got != code {
+			t.Errorf("String() = %q, expected %q", got, code)
+		}
+	}
+}
+
+func TestRomPatch(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	m.Memory[0x0123] = 0x22
+	uri := "test:rompatch"
+
+	c, err := Add(ctx, m, uri, "3C64-0DAD", "no damage", sni.MemoryMapping_LoROM, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.IsRomPatch() || c.PakAddress != 0x0123 || !c.Enabled {
+		t.Fatalf("unexpected cheat %+v", c)
+	}
+	if m.Memory[0x0123] != 0xEA {
+		t.Fatalf("expected ROM to be patched but got $%02x", m.Memory[0x0123])
+	}
+
+	if c, err = SetEnabled(ctx, m, uri, c.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	if c.Enabled || m.Memory[0x0123] != 0x22 {
+		t.Fatalf("expected original ROM byte to be restored but got $%02x", m.Memory[0x0123])
+	}
+
+	if err = Remove(ctx, m, uri, c.ID); err != nil {
+		t.Fatal(err)
+	}
+	if list := List(uri); len(list) != 0 {
+		t.Fatalf("expected no cheats but got %+v", list)
+	}
+	if _, err = SetEnabled(ctx, m, uri, c.ID, true); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+}
+
+// slowFreezing keeps the freezer from ticking during a test; the test scheme has no driver so it would stop at once:
+func slowFreezing(t *testing.T) {
+	frames := config.Config.Get("cheats_freeze_frames")
+	config.Config.Set("cheats_freeze_frames", 6000)
+	t.Cleanup(func() { config.Config.Set("cheats_freeze_frames", frames) })
+}
+
+func TestRamFreeze(t *testing.T) {
+	slowFreezing(t)
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	uri := "test:ramfreeze"
+
+	c, err := Add(ctx, m, uri, "7E0DBE09", "lives", sni.MemoryMapping_LoROM, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.IsRomPatch() || c.MemoryType != mapping.MemoryTypeWRAM || c.PakAddress != 0xF5_0DBE {
+		t.Fatalf("unexpected cheat %+v", c)
+	}
+	if m.Memory[0xF5_0DBE] != 0x09 {
+		t.Fatal("expected RAM to be written when enabled")
+	}
+
+	d := cheatsFor(uri)
+	writes, ok := d.frozen()
+	if !ok || len(writes) != 1 || writes[0].RequestAddress.Address != 0xF5_0DBE || writes[0].Data[0] != 0x09 {
+		t.Fatalf("expected the cheat to be frozen: %+v", writes)
+	}
+
+	if _, err = SetEnabled(ctx, m, uri, c.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok = d.frozen(); ok {
+		t.Fatal("expected nothing to be frozen once disabled")
+	}
+
+	// codes outside ROM and RAM are rejected:
+	if _, err = Add(ctx, m, uri, "00210009", "", sni.MemoryMapping_LoROM, false); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument but got %v", err)
+	}
+}
+
+func TestFreezeOnce(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	writes := []devices.MemoryWriteRequest{
+		{RequestAddress: devices.AddressTuple{Address: 0xF5_0DBE, AddressSpace: sni.AddressSpace_FxPakPro}, Data: []byte{0x09}},
+		{RequestAddress: devices.AddressTuple{Address: 0xF5_0DBF, AddressSpace: sni.AddressSpace_FxPakPro}, Data: []byte{0x03}},
+	}
+
+	n, err := freezeOnce(ctx, m, writes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || m.Memory[0xF5_0DBE] != 0x09 || m.Memory[0xF5_0DBF] != 0x03 {
+		t.Fatalf("expected both values written but wrote %d", n)
+	}
+
+	// unchanged values are not written again:
+	if n, err = freezeOnce(ctx, m, writes); err != nil || n != 0 {
+		t.Fatalf("expected no writes but wrote %d: %v", n, err)
+	}
+
+	// only the value the game changed is written back:
+	m.Memory[0xF5_0DBF] = 0x02
+	if n, err = freezeOnce(ctx, m, writes); err != nil || n != 1 || m.Memory[0xF5_0DBF] != 0x03 {
+		t.Fatalf("expected one write but wrote %d: %v", n, err)
+	}
+}
+
+func TestFreezeStopsWithoutDevice(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	uri := "nodriver:freeze"
+
+	if _, err := Add(ctx, m, uri, "7E0DBE09", "lives", sni.MemoryMapping_LoROM, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// the scheme has no driver so the freezer gives up and disables the RAM cheat:
+	d := cheatsFor(uri)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		d.mu.Lock()
+		enabled, freezing := d.cheats[0].Enabled, d.freezing
+		d.mu.Unlock()
+		if !enabled && !freezing {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected freezing to stop")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,126 @@
+// Package cheats decodes Game Genie and Pro Action Replay codes and applies them to devices, patching ROM for codes
+// that target ROM and freezing memory for codes that target RAM.
+package cheats
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"strconv"
+	"strings"
+)
+
+// Format is the format a cheat code is written in.
+type Format int
+
+const (
+	// GameGenie codes are written as "DDAA-AAAA" in the Game Genie alphabet with the address bits scrambled:
+	GameGenie Format = iota
+	// ProActionReplay codes are written as "AAAAAADD" in hexadecimal:
+	ProActionReplay
+)
+
+func (f Format) String() string {
+	switch f {
+	case GameGenie:
+		return "Game Genie"
+	case ProActionReplay:
+		return "Pro Action Replay"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// Code is a decoded cheat code that replaces the byte at an address on the SNES A bus.
+type Code struct {
+	Format  Format
+	Address uint32
+	Value   uint8
+}
+
+// gameGenieAlphabet lists the Game Genie letters in the order of the hexadecimal digits they stand for:
+const gameGenieAlphabet = "DF4709156BC8A23E"
+
+// Decode decodes a Game Genie code, written with a dash after the 4th letter as in "C9C4-6F6D", or a Pro Action
+// Replay code, written as 8 hexadecimal digits with an optional colon before the value as in "7E0DBE09" or
+// "7E0DBE:09".
+func Decode(code string) (c Code, err error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if len(code) == 9 && code[4] == '-' {
+		return decodeGameGenie(code[:4] + code[5:])
+	}
+
+	hex := strings.Replace(code, ":", "", 1)
+	if len(hex) != 8 || (len(hex) != len(code) && code[6] != ':') {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("cheats: '%s' is neither a Game Genie code (XXXX-XXXX) nor a Pro Action Replay code (XXXXXXXX)", code))
+		return
+	}
+
+	var raw uint64
+	if raw, err = strconv.ParseUint(hex, 16, 32); err != nil {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("cheats: invalid Pro Action Replay code '%s'", code))
+		return
+	}
+
+	c = Code{
+		Format:  ProActionReplay,
+		Address: uint32(raw >> 8),
+		Value:   uint8(raw),
+	}
+	return
+}
+
+func decodeGameGenie(letters string) (c Code, err error) {
+	var raw uint32
+	for _, letter := range letters {
+		digit := strings.IndexRune(gameGenieAlphabet, letter)
+		if digit < 0 {
+			err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("cheats: invalid Game Genie letter '%c'", letter))
+			return
+		}
+		raw = raw<<4 | uint32(digit)
+	}
+
+	// the 24 address bits are stored in the order ijklqrstopabcduvwxefghmn:
+	a := raw & 0xFF_FFFF
+	c = Code{
+		Format: GameGenie,
+		Address: (a&0x00_3C00)<<10 |
+			(a&0x00_003C)<<14 |
+			(a&0xF0_0000)>>8 |
+			(a&0x00_0003)<<10 |
+			(a&0x00_C000)>>6 |
+			(a&0x0F_0000)>>12 |
+			(a&0x00_03C0)>>6,
+		Value: uint8(raw >> 24),
+	}
+	return
+}
+
+// String formats the code in its own format.
+func (c Code) String() string {
+	switch c.Format {
+	case GameGenie:
+		a := c.Address
+		raw := uint32(c.Value)<<24 |
+			(a&0xF0_0000)>>10 |
+			(a&0x0F_0000)>>14 |
+			(a&0x00_F000)<<8 |
+			(a&0x00_0C00)>>10 |
+			(a&0x00_0300)<<6 |
+			(a&0x00_00F0)<<12 |
+			(a&0x00_000F)<<6
+
+		var sb strings.Builder
+		for i := 7; i >= 0; i-- {
+			sb.WriteByte(gameGenieAlphabet[(raw>>(i*4))&0xF])
+			if i == 4 {
+				sb.WriteByte('-')
+			}
+		}
+		return sb.String()
+	default:
+		return fmt.Sprintf("%06X%02X", c.Address, c.Value)
+	}
+}
@@ -3,8 +3,15 @@ package emunwa
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"net"
 	"reflect"
+	"sni/devices"
+	"sni/protos/sni"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func Test_parseResponse(t *testing.T) {
@@ -89,3 +96,80 @@ func Test_parseResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_MultiReadMemory_Domains(t *testing.T) {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// the emulator replies to each command with as many bytes as requested, all set to the command number:
+	commands := make(chan []string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			commands <- nil
+			return
+		}
+		defer conn.Close()
+
+		var received []string
+		r := bufio.NewReader(conn)
+		for i := byte(1); i <= 7; i++ {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				break
+			}
+			line = strings.TrimSuffix(line, "\n")
+			received = append(received, line)
+
+			// arguments are pairs of offset and size:
+			size := 0
+			args := strings.Split(line, ";")[1:]
+			for j := 1; j < len(args); j += 2 {
+				n, _ := strconv.ParseUint(strings.TrimPrefix(args[j], "$"), 16, 32)
+				size += int(n)
+			}
+			rsp := []byte{0, 0, 0, 0, byte(size)}
+			_, _ = conn.Write(append(rsp, bytes.Repeat([]byte{i}, size)...))
+		}
+		commands <- received
+	}()
+
+	c := NewClient(l.Addr().(*net.TCPAddr), "test", time.Second)
+	if err = c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var reads []devices.MemoryReadRequest
+	for _, address := range []uint32{0xF7_0010, 0xF8_0020, 0xF9_0002, 0xF9_0204, 0xF9_0421, 0xF9_0500, 0xF9_0708} {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro},
+			Size:           2,
+		})
+	}
+	rsps, err := c.MultiReadMemory(context.Background(), reads...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"CORE_READ VRAM;$10;$2",
+		"CORE_READ APURAM;$20;$2",
+		"CORE_READ CGRAM;$2;$2",
+		"CORE_READ OAM;$4;$2",
+		"CORE_READ MISC;$1;$2",
+		"CORE_READ PPUREG;$0;$2",
+		"CORE_READ CPUREG;$8;$2",
+	}
+	if received := <-commands; !reflect.DeepEqual(received, expected) {
+		t.Fatalf("expected commands %q but got %q", expected, received)
+	}
+	for i, rsp := range rsps {
+		if !bytes.Equal(rsp.Data, []byte{byte(i + 1), byte(i + 1)}) {
+			t.Errorf("read %d: unexpected data %v", i, rsp.Data)
+		}
+	}
+}
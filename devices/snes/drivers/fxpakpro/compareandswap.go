@@ -0,0 +1,311 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/asm"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+)
+
+func isWRAM(fxpakproAddress uint32) bool {
+	return fxpakproAddress >= 0xF50000 && fxpakproAddress < 0xF70000
+}
+
+// MultiCompareAndSwapMemory performs conditional writes. Conditional writes to WRAM are compared and copied by the
+// SNES itself during NMI via the USB EXE feature so that they are atomic with respect to the game. All other
+// conditional writes are compared and written while holding the device lock. Writes are issued in request order.
+//
+// Unconditional writes cannot be mixed with conditional writes in one request since they could not be made atomic
+// with the WRAM compares done by the SNES.
+func (d *Device) MultiCompareAndSwapMemory(
+	ctx context.Context,
+	writes ...devices.MemoryWriteRequest,
+) (mrsp []devices.MemoryWriteResponse, err error) {
+	conditional := 0
+	for _, write := range writes {
+		if write.Expected != nil {
+			conditional++
+		}
+	}
+	if conditional != len(writes) {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: conditional and unconditional writes cannot be mixed in one request"))
+	}
+
+	// make all the response structs:
+	mrsp = make([]devices.MemoryWriteResponse, len(writes))
+	for j, write := range writes {
+		mrsp[j] = devices.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress: devices.AddressTuple{
+				Address:       0,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: write.RequestAddress.MemoryMapping,
+			},
+			Size: len(write.Data),
+		}
+
+		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
+			write.RequestAddress,
+			sni.AddressSpace_FxPakPro,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subctx := ctx
+	if shouldLock(ctx) {
+		// lock the device for this entire sequence to avoid interruptions:
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	// handle runs of consecutive WRAM and non-WRAM writes in turn to keep the request order:
+	for i := 0; i < len(writes); {
+		wram := isWRAM(mrsp[i].DeviceAddress.Address)
+		run := make([]int, 0, len(writes)-i)
+		for ; i < len(writes) && isWRAM(mrsp[i].DeviceAddress.Address) == wram; i++ {
+			run = append(run, i)
+		}
+
+		if wram {
+			err = d.compareAndCopyWRAM(subctx, writes, mrsp, run)
+		} else {
+			err = d.compareAndWrite(subctx, writes, mrsp, run)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// compareAndWrite reads the current contents of memory for the given writes and issues those that match. Must be
+// called with the device lock held.
+func (d *Device) compareAndWrite(ctx context.Context, writes []devices.MemoryWriteRequest, mrsp []devices.MemoryWriteResponse, run []int) (err error) {
+	compareReads := make([]devices.MemoryReadRequest, 0, len(run))
+	for _, j := range run {
+		compareReads = append(compareReads, devices.MemoryReadRequest{
+			RequestAddress: mrsp[j].DeviceAddress,
+			Size:           len(writes[j].Expected),
+		})
+	}
+
+	var rrsp []devices.MemoryReadResponse
+	rrsp, err = d.MultiReadMemory(ctx, compareReads...)
+	if err != nil {
+		return
+	}
+
+	matched := make([]devices.MemoryWriteRequest, 0, len(run))
+	for i, j := range run {
+		if !bytes.Equal(rrsp[i].Data, writes[j].Expected) {
+			mrsp[j].CompareFailed = true
+			mrsp[j].Size = 0
+			continue
+		}
+		matched = append(matched, devices.MemoryWriteRequest{
+			RequestAddress: mrsp[j].DeviceAddress,
+			Data:           writes[j].Data,
+		})
+	}
+	if len(matched) > 0 {
+		_, err = d.MultiWriteMemory(ctx, matched...)
+	}
+	return
+}
+
+// compareAndCopyWRAM compares and copies the given WRAM writes on the SNES using the USB EXE feature of the fxpakpro.
+// Must be called with the device lock held.
+func (d *Device) compareAndCopyWRAM(ctx context.Context, writes []devices.MemoryWriteRequest, mrsp []devices.MemoryWriteResponse, run []int) (err error) {
+	wramWrites := make([]devices.MemoryWriteRequest, 0, len(run))
+	for _, j := range run {
+		wramWrites = append(wramWrites, devices.MemoryWriteRequest{
+			RequestAddress: mrsp[j].DeviceAddress,
+			Data:           writes[j].Data,
+			Expected:       writes[j].Expected,
+		})
+	}
+
+	remainingWRAMWrites := wramWrites[:]
+	for len(remainingWRAMWrites) > 0 {
+		code := [512]byte{}
+		a := asm.NewEmitter(code[:], true)
+
+		// generate a compare and copy routine and return the remaining writes that didn't fit:
+		var resultsAddr uint32
+		count := len(remainingWRAMWrites)
+		remainingWRAMWrites, resultsAddr, err = GenerateCompareCopyAsm(a, remainingWRAMWrites...)
+		if err != nil {
+			return
+		}
+		count -= len(remainingWRAMWrites)
+
+		if debugLog != nil {
+			a.WriteTextTo(debugLog.Writer())
+		}
+
+		err = d.runUSBEXE(ctx, code[:a.Len()])
+		if err != nil {
+			return
+		}
+
+		// read back the compare results from the snescmd buffer:
+		results := make([]byte, count)
+		err = d.vget(ctx, SpaceCMD, vgetChunk{addr: resultsAddr, size: uint8(count), target: results})
+		if err != nil {
+			return
+		}
+
+		for i, result := range results {
+			j := run[i]
+			if result == 0 {
+				mrsp[j].CompareFailed = true
+				mrsp[j].Size = 0
+			}
+		}
+		run = run[count:]
+	}
+
+	return
+}
+
+// GenerateCompareCopyAsm generates a routine which, for each write, compares the current contents of WRAM with the
+// write's Expected bytes and only if all match copies the write's Data into WRAM. Writes must be in FX Pak Pro
+// address space and have Expected set. Each write's compare result (1 for written, 0 for compare failed) is stored in
+// one byte of the routine's buffer in the snescmd space starting at resultsAddr. Writes are never split; those that
+// do not fit are returned in remainder.
+func GenerateCompareCopyAsm(a *asm.Emitter, writeRequests ...devices.MemoryWriteRequest) (remainder []devices.MemoryWriteRequest, resultsAddr uint32, err error) {
+	// sizeRoutine represents the total size of ASM code below without compare+MVN blocks:
+	const sizeRoutine = 22
+	// sizeCompareBlock represents the size of the code to compare and copy one write without its per-byte compares:
+	const sizeCompareBlock = 26
+	// sizeCompareByte represents the size of the code to compare one byte:
+	const sizeCompareByte = 11
+
+	writes := make([]devices.MemoryWriteRequest, 0, len(writeRequests))
+	codeSize := sizeRoutine
+	dataSize := 0
+	{
+		// see how much we can fit in our asm buffer; each write needs its code, its data, and a result byte:
+		sizeRemaining := a.Cap() - sizeRoutine
+		for i, w := range writeRequests {
+			if len(w.Expected) != len(w.Data) || len(w.Data) == 0 {
+				err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: conditional write must have equal length non-empty expected bytes and data"))
+				return
+			}
+
+			size := sizeCompareBlock + sizeCompareByte*len(w.Expected) + len(w.Data) + 1
+			if sizeRemaining < size {
+				if i == 0 {
+					err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: conditional WRAM write of $%x bytes too large for the snescmd buffer", len(w.Data)))
+					return
+				}
+				remainder = append(remainder, writeRequests[i:]...)
+				break
+			}
+
+			writes = append(writes, w)
+			sizeRemaining -= size
+			codeSize += sizeCompareBlock + sizeCompareByte*len(w.Expected)
+			dataSize += len(w.Data)
+		}
+	}
+
+	a.SetBase(0x002C00)
+
+	a.Comment("preserve registers:")
+
+	// save flags; switch to 16-bit X,Y mode:
+	a.PHP()
+	a.REP(0x30)
+
+	// MVN affects A, X, Y, DBR registers:
+	a.PHA()
+	a.PHX()
+	a.PHY()
+	a.PHB()
+
+	srcOffs := uint16(0x2C00 + codeSize)
+	resultsAddr = uint32(0x2C00 + codeSize + dataSize)
+	for i, write := range writes {
+		data := write.Data
+		size := uint16(len(data))
+		targetFXPakProAddress := write.RequestAddress.Address
+		targetBusAddress := 0x7E_0000 + (targetFXPakProAddress - 0xF5_0000)
+		destBank := uint8(targetBusAddress >> 16)
+		destOffs := uint16(targetBusAddress & 0xFFFF)
+		skipLabel := fmt.Sprintf("skip%d", i)
+
+		a.Comment(fmt.Sprintf("compare $%04x bytes at $%02x:%04x", size, destBank, destOffs))
+		a.SEP(0x20)
+		for k, b := range write.Expected {
+			a.LDA_long(targetBusAddress + uint32(k))
+			a.CMP_imm8_b(b)
+			a.BEQ_imm8(3)
+			a.JMP_abs(skipLabel)
+		}
+		a.REP(0x20)
+
+		a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to $%02x:%04x", size, srcOffs, destBank, destOffs))
+		// A - Specifies the amount of bytes to transfer, minus 1
+		a.LDA_imm16_w(size - 1)
+		// X - Specifies the high and low bytes of the data source memory address
+		a.LDX_imm16_w(srcOffs)
+		// Y - Specifies the high and low bytes of the destination memory address
+		a.LDY_imm16_w(destOffs)
+		// MVN sets DBR to destination bank
+		a.MVN(destBank, 0x00)
+
+		a.Comment("record success:")
+		a.SEP(0x20)
+		a.LDA_imm8_b(0x01)
+		a.STA_long(resultsAddr + uint32(i))
+
+		a.Label(skipLabel)
+		a.REP(0x20)
+
+		srcOffs += size
+	}
+	// restore DBR register so the STZ_abs works correctly:
+	a.PLB()
+
+	a.Comment("disable NMI vector override:")
+	a.LDA_imm16_w(0)
+	a.STA_long(0x002C00)
+
+	a.Comment("restore registers:")
+	a.PLY()
+	a.PLX()
+	a.PLA()
+
+	// restore flags
+	a.PLP()
+
+	a.Comment("jump to original NMI:")
+	a.JMP_indirect(0xFFEA)
+
+	if err = a.Finalize(); err != nil {
+		return
+	}
+
+	// bug check: make sure emitted code is the expected size
+	if actual, expected := a.Len(), codeSize; actual != expected {
+		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+	}
+
+	// copy in the data to be written to WRAM:
+	for _, write := range writes {
+		a.EmitBytes(write.Data)
+	}
+	// results start out as compare failed:
+	a.EmitBytes(make([]byte, len(writes)))
+
+	return
+}
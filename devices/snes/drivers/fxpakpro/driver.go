@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.bug.st/serial"
 	"go.bug.st/serial/enumerator"
@@ -44,6 +45,11 @@ const defaultAddressSpace = sni.AddressSpace_FxPakPro
 type Driver struct {
 	container devices.DeviceContainer
 	enumLock  sync.Mutex
+
+	// stableIds maps port name to stable device identifier as of the last Detect; protected by enumLock:
+	stableIds map[string]string
+	// lastDetect is when stableIds was last refreshed; protected by enumLock:
+	lastDetect time.Time
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -105,6 +111,7 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 		return
 	}
 
+	fxpakPorts := make([]*enumerator.PortDetails, 0, len(ports))
 	for _, port := range ports {
 		if !port.IsUSB {
 			continue
@@ -113,17 +120,37 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 		// When more than one fxpakpro is connected only one of the devices gets the SerialNumber="DEMO00000000";
 		// This is likely a bug in serial library.
 		if (port.SerialNumber == "DEMO00000000") || (port.VID == "1209" && port.PID == "5A22") {
-			devs = append(devs, devices.DeviceDescriptor{
-				Uri:                 url.URL{Scheme: driverName, Host: ".", Path: port.Name},
-				DisplayName:         fmt.Sprintf("%s (%s:%s)", port.Name, port.VID, port.PID),
-				Kind:                d.Kind(),
-				Capabilities:        driverCapabilities[:],
-				DefaultAddressSpace: defaultAddressSpace,
-				System:              "snes",
-			})
+			fxpakPorts = append(fxpakPorts, port)
 		}
 	}
 
+	// serial numbers are unreliable so fall back to USB location path for identifying devices across reconnects:
+	d.stableIds = stableDeviceIds(fxpakPorts)
+	d.lastDetect = time.Now()
+	aliases := configuredAliases()
+
+	for _, port := range fxpakPorts {
+		displayName := fmt.Sprintf("%s (%s:%s)", port.Name, port.VID, port.PID)
+
+		var stable url.URL
+		if id, ok := d.stableIds[port.Name]; ok {
+			stable = stableUri(aliases, id)
+			if stable.Host == stableAliasHost {
+				displayName = fmt.Sprintf("%s [%s]", displayName, aliasFor(aliases, id))
+			}
+		}
+
+		devs = append(devs, devices.DeviceDescriptor{
+			Uri:                 url.URL{Scheme: driverName, Host: ".", Path: port.Name},
+			StableUri:           stable,
+			DisplayName:         displayName,
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: defaultAddressSpace,
+			System:              "snes",
+		})
+	}
+
 	err = nil
 	return
 }
@@ -167,6 +194,18 @@ func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err
 
 func (d *Driver) DeviceKey(uri *url.URL) (key string) {
 	key = uri.Path
+	if id, isStable := stableIdFromUri(uri); isStable {
+		var err error
+		key, err = d.portName(uri)
+		if err != nil {
+			// not connected; keep the key distinct from any port:
+			return "id:" + id
+		}
+		// match the path of parsed port URIs, e.g. `/COM4` on Windows:
+		if !strings.HasPrefix(key, "/") {
+			key = "/" + key
+		}
+	}
 	// macos/linux paths:
 	if strings.HasPrefix(key, "/dev/") {
 		key = key[len("/dev/"):]
@@ -181,7 +220,11 @@ func (d *Driver) DeviceKey(uri *url.URL) (key string) {
 }
 
 func (d *Driver) openDevice(uri *url.URL) (device devices.Device, err error) {
-	portName := uri.Path
+	var portName string
+	portName, err = d.portName(uri)
+	if err != nil {
+		return
+	}
 
 	var baudRequest int
 	if runtime.GOOS == "darwin" {
@@ -0,0 +1,163 @@
+package fxpakpro
+
+import (
+	"fmt"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"strings"
+	"time"
+
+	"go.bug.st/serial/enumerator"
+	"google.golang.org/grpc/codes"
+)
+
+// Stable device URIs identify a device independently of the serial port it is currently connected to:
+//
+//	fxpakpro://id/sn-<serial number>   when the device reports a serial number unique among connected devices
+//	fxpakpro://id/usb-<location>       the USB topology path (bus and port numbers) the device is plugged into
+//	fxpakpro://alias/<name>            a user-assigned alias for either of the above, see SNI_FXPAKPRO_ALIASES
+//
+// Both forms are resolved back to the current serial port by DeviceKey and openDevice.
+const (
+	stableIdHost    = "id"
+	stableAliasHost = "alias"
+)
+
+// unreliableSerialNumber is reported as the serial number of one of the devices when more than one FX Pak Pro is
+// connected, likely because of a bug in the serial library, and so cannot identify one:
+const unreliableSerialNumber = "DEMO00000000"
+
+// stableDeviceId derives a stable identifier for a device from its USB serial number if that is trustworthy and
+// otherwise from its USB location path. Returns "" if neither is available.
+func stableDeviceId(serialNumber string, serialUnique bool, location string) string {
+	if serialNumber != "" && serialNumber != unreliableSerialNumber && serialUnique {
+		return "sn-" + serialNumber
+	}
+	if location != "" {
+		return "usb-" + location
+	}
+	return ""
+}
+
+// stableDeviceIds maps each port name to the stable identifier derived for it.
+func stableDeviceIds(ports []*enumerator.PortDetails) (ids map[string]string) {
+	serialCount := make(map[string]int, len(ports))
+	for _, port := range ports {
+		serialCount[port.SerialNumber]++
+	}
+
+	ids = make(map[string]string, len(ports))
+	for _, port := range ports {
+		id := stableDeviceId(port.SerialNumber, serialCount[port.SerialNumber] == 1, usbLocation(port.Name))
+		if id == "" {
+			continue
+		}
+		ids[port.Name] = id
+	}
+	return
+}
+
+// parseAliases parses a comma-delimited list of `alias=id` pairs into a map of alias to stable identifier.
+func parseAliases(aliasesStr string) (aliases map[string]string) {
+	aliases = make(map[string]string)
+	for _, pair := range strings.Split(aliasesStr, ",") {
+		alias, id, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		alias, id = strings.TrimSpace(alias), strings.TrimSpace(id)
+		if alias == "" || id == "" {
+			continue
+		}
+		aliases[alias] = id
+	}
+	return
+}
+
+func configuredAliases() map[string]string {
+	return parseAliases(config.Config.GetString("fxpakpro_aliases"))
+}
+
+// aliasFor returns the first alias, in sorted order, configured for the given stable identifier.
+func aliasFor(aliases map[string]string, id string) (alias string) {
+	for a, i := range aliases {
+		if i != id {
+			continue
+		}
+		if alias == "" || a < alias {
+			alias = a
+		}
+	}
+	return
+}
+
+// stableUri returns the URI to expose for a device with the given stable identifier, preferring its alias.
+func stableUri(aliases map[string]string, id string) url.URL {
+	if alias := aliasFor(aliases, id); alias != "" {
+		return url.URL{Scheme: driverName, Host: stableAliasHost, Path: "/" + alias}
+	}
+	return url.URL{Scheme: driverName, Host: stableIdHost, Path: "/" + id}
+}
+
+// stableIdFromUri returns the stable identifier referred to by a stable device URI. isStable is false for URIs
+// that refer to a serial port directly.
+func stableIdFromUri(uri *url.URL) (id string, isStable bool) {
+	switch uri.Host {
+	case stableIdHost:
+		return strings.TrimPrefix(uri.Path, "/"), true
+	case stableAliasHost:
+		alias := strings.TrimPrefix(uri.Path, "/")
+		if id, ok := configuredAliases()[alias]; ok {
+			return id, true
+		}
+		// an unknown alias resolves to no device:
+		return "alias:" + alias, true
+	default:
+		return "", false
+	}
+}
+
+// redetectInterval limits how often portName re-detects devices to resolve a stable identifier that is not connected:
+const redetectInterval = time.Second * 2
+
+// portName returns the serial port the device referred to by uri is currently connected to.
+func (d *Driver) portName(uri *url.URL) (portName string, err error) {
+	id, isStable := stableIdFromUri(uri)
+	if !isStable {
+		return uri.Path, nil
+	}
+
+	var ok bool
+	var detectedAt time.Time
+	if portName, ok, detectedAt = d.portForId(id); ok {
+		return
+	}
+
+	// the device may have been re-plugged since the last detection, unless that was too recent to tell:
+	if time.Since(detectedAt) >= redetectInterval {
+		if _, err = d.Detect(); err != nil {
+			return
+		}
+		if portName, ok, _ = d.portForId(id); ok {
+			return
+		}
+	}
+
+	err = devices.WithCode(codes.NotFound, fmt.Errorf("%s: no device connected with id '%s'", driverName, id))
+	return
+}
+
+// portForId looks up the port for a stable identifier as of the last Detect, which happened at detectedAt.
+func (d *Driver) portForId(id string) (portName string, ok bool, detectedAt time.Time) {
+	d.enumLock.Lock()
+	defer d.enumLock.Unlock()
+
+	detectedAt = d.lastDetect
+	for p, i := range d.stableIds {
+		if i == id {
+			return p, true, detectedAt
+		}
+	}
+	return "", false, detectedAt
+}
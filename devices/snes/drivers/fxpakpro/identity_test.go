@@ -0,0 +1,110 @@
+package fxpakpro
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"testing"
+	"time"
+
+	"go.bug.st/serial/enumerator"
+	"google.golang.org/grpc/codes"
+)
+
+func TestStableDeviceId(t *testing.T) {
+	tests := []struct {
+		name         string
+		serialNumber string
+		serialUnique bool
+		location     string
+		want         string
+	}{
+		{"unique serial", "ABC123", true, "1-2", "sn-ABC123"},
+		{"duplicate serial", "ABC123", false, "1-2", "usb-1-2"},
+		{"firmware serial", unreliableSerialNumber, true, "1-1.4", "usb-1-1.4"},
+		{"no serial", "", true, "3-1", "usb-3-1"},
+		{"nothing", unreliableSerialNumber, true, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stableDeviceId(tt.serialNumber, tt.serialUnique, tt.location); got != tt.want {
+				t.Errorf("stableDeviceId() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStableDeviceIds_DuplicateSerials(t *testing.T) {
+	ids := stableDeviceIds([]*enumerator.PortDetails{
+		{Name: "/dev/fake-a", SerialNumber: "SAME"},
+		{Name: "/dev/fake-b", SerialNumber: "SAME"},
+		{Name: "/dev/fake-c", SerialNumber: "OTHER"},
+	})
+
+	// fake ports have no USB location so only the unique serial number can identify its device:
+	want := map[string]string{"/dev/fake-c": "sn-OTHER"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Fatalf("stableDeviceIds() = %v, want %v", ids, want)
+	}
+}
+
+func TestParseAliases(t *testing.T) {
+	got := parseAliases(" living-room = usb-1-1.2,desk=sn-ABC,,bad,=usb-1-3,empty=")
+	want := map[string]string{
+		"living-room": "usb-1-1.2",
+		"desk":        "sn-ABC",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestStableUri(t *testing.T) {
+	aliases := map[string]string{"zzz": "usb-1-2", "desk": "usb-1-2"}
+
+	if got, want := stableUri(aliases, "usb-1-2"), "fxpakpro://alias/desk"; got.String() != want {
+		t.Errorf("stableUri() = %v, want %v", got.String(), want)
+	}
+	if got, want := stableUri(aliases, "usb-1-3"), "fxpakpro://id/usb-1-3"; got.String() != want {
+		t.Errorf("stableUri() = %v, want %v", got.String(), want)
+	}
+}
+
+func TestDeviceKey_StableUri(t *testing.T) {
+	config.Config.Set("fxpakpro_aliases", "desk=usb-1-2")
+	t.Cleanup(func() { config.Config.Set("fxpakpro_aliases", "") })
+
+	d := &Driver{stableIds: map[string]string{"/dev/ttyACM1": "usb-1-2"}}
+	portKey := d.DeviceKey(&url.URL{Scheme: driverName, Host: ".", Path: "/dev/ttyACM1"})
+
+	for _, uri := range []string{"fxpakpro://id/usb-1-2", "fxpakpro://alias/desk"} {
+		u, err := url.Parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if key := d.DeviceKey(u); key != portKey {
+			t.Errorf("DeviceKey(%s) = %v, want %v", uri, key, portKey)
+		}
+		if portName, err := d.portName(u); err != nil || portName != "/dev/ttyACM1" {
+			t.Errorf("portName(%s) = %v, %v", uri, portName, err)
+		}
+	}
+}
+
+func TestPortName_CachesMisses(t *testing.T) {
+	detectedAt := time.Now()
+	d := &Driver{stableIds: map[string]string{"/dev/ttyACM1": "usb-1-2"}, lastDetect: detectedAt}
+
+	u := &url.URL{Scheme: driverName, Host: stableIdHost, Path: "/usb-9-9"}
+	var coded *devices.CodedError
+	if _, err := d.portName(u); !errors.As(err, &coded) || coded.Code != codes.NotFound {
+		t.Fatalf("portName() err = %v, want NotFound", err)
+	}
+	// a detection this recent must not be repeated for a device that is not connected:
+	if !d.lastDetect.Equal(detectedAt) || d.stableIds["/dev/ttyACM1"] != "usb-1-2" {
+		t.Fatalf("portName() re-detected devices")
+	}
+}
@@ -270,80 +270,83 @@ func (d *Device) MultiWriteMemory(
 			)
 		}
 
-		chunks := make([]vputChunk, 0, 8)
-		startAddr := uint32(0x2C00)
-		addr := startAddr
-		data := code[:a.Len()]
-		size := len(data)
-		for size > 0 {
-			chunkSize := 255
-			if size < chunkSize {
-				chunkSize = size
-			}
+		err = d.runUSBEXE(subctx, code[:a.Len()])
+		if err != nil {
+			return
+		}
+	}
 
-			// 4-byte struct: 1 byte size, 3 byte address
-			chunks = append(chunks, vputChunk{
-				addr: addr,
-				// target offset to write to in Data[] for MemoryWriteResponse:
-				data: data[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
-			})
+	return
+}
 
-			size -= 255
-			addr += 255
+// runUSBEXE uploads the given 65816 code to the snescmd buffer at $2C00 for the SNES to execute during its next NMI
+// and waits for it to complete.
+func (d *Device) runUSBEXE(ctx context.Context, code []byte) (err error) {
+	chunks := make([]vputChunk, 0, 8)
+	startAddr := uint32(0x2C00)
+	addr := startAddr
+	size := len(code)
+	for size > 0 {
+		chunkSize := 255
+		if size < chunkSize {
+			chunkSize = size
 		}
 
-		if actual, expected := len(chunks), 8; actual > expected {
-			return nil, fmt.Errorf(
-				"fxpakpro: too many VPUT chunks to write WRAM data with; %d > %d",
-				actual,
-				expected,
-			)
-		}
+		// 4-byte struct: 1 byte size, 3 byte address
+		chunks = append(chunks, vputChunk{
+			addr: addr,
+			data: code[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
+		})
 
-		// await 5 seconds in game-frames for USB EXE:
-		awaitctx, awaitcancel := context.WithTimeout(subctx, timing.Frame*60*5)
-
-		// VGET to await USB EXE availability:
-		{
-			var ok bool
-			ok, err = d.awaitUSBEXE(awaitctx)
-			if err != nil {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write: %w", err)
-				return
-			}
-			if !ok {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write")
-				return
-			}
-		}
+		size -= 255
+		addr += 255
+	}
 
-		// VPUT command to CMD space:
-		err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if actual, expected := len(chunks), 8; actual > expected {
+		return fmt.Errorf(
+			"fxpakpro: too many VPUT chunks to write USB EXE code with; %d > %d",
+			actual,
+			expected,
+		)
+	}
+
+	// await 5 seconds in game-frames for USB EXE:
+	awaitctx, awaitcancel := context.WithTimeout(ctx, timing.Frame*60*5)
+	defer awaitcancel()
+
+	// VGET to await USB EXE availability:
+	{
+		var ok bool
+		ok, err = d.awaitUSBEXE(awaitctx)
 		if err != nil {
-			awaitcancel()
-			err = fmt.Errorf("fxpakpro: could not VPUT to USB EXE: %w", err)
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write: %w", err)
 			return
 		}
-
-		// await USB EXE availability to validate the write was completed:
-		{
-			var ok bool
-			ok, err = d.awaitUSBEXE(awaitctx)
-			if err != nil {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write: %w", err)
-				return
-			}
-			if !ok {
-				awaitcancel()
-				err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write")
-				return
-			}
+		if !ok {
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-write")
+			return
 		}
+	}
 
-		awaitcancel()
+	// VPUT command to CMD space:
+	err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not VPUT to USB EXE: %w", err)
+		return
+	}
+
+	// await USB EXE availability to validate the write was completed:
+	{
+		var ok bool
+		ok, err = d.awaitUSBEXE(awaitctx)
+		if err != nil {
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write: %w", err)
+			return
+		}
+		if !ok {
+			err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-write")
+			return
+		}
 	}
 
 	return
@@ -2,7 +2,9 @@ package fxpakpro
 
 import (
 	"context"
+	"errors"
 	"github.com/alttpo/snes/asm"
+	"google.golang.org/grpc/codes"
 	"log"
 	"reflect"
 	"sni/devices"
@@ -216,3 +218,103 @@ func TestDevice_MultiWriteMemory(t *testing.T) {
 	}
 	_ = rsp
 }
+
+func TestGenerateCompareCopyAsm(t *testing.T) {
+	wramWrite := func(addr uint32, expected, data []byte) devices.MemoryWriteRequest {
+		return devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       addr,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Data:     data,
+			Expected: expected,
+		}
+	}
+
+	writes := []devices.MemoryWriteRequest{
+		wramWrite(0xF50010, []byte{0x01, 0x02}, []byte{0x11, 0x12}),
+		wramWrite(0xF6FFF0, []byte{0x03}, []byte{0x13}),
+	}
+
+	code := make([]byte, 512)
+	a := asm.NewEmitter(code, true)
+	remainder, resultsAddr, err := GenerateCompareCopyAsm(a, writes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.WriteTextTo(log.Writer())
+
+	if remainder != nil {
+		t.Fatalf("expected no remainder; got %v", remainder)
+	}
+
+	codeSize := 22 + (26 + 11*2) + (26 + 11*1)
+	if actual, expected := resultsAddr, uint32(0x2C00+codeSize+3); actual != expected {
+		t.Fatalf("resultsAddr = %#x, expected %#x", actual, expected)
+	}
+	if actual, expected := a.Len(), codeSize+3+2; actual != expected {
+		t.Fatalf("emitted size = %d, expected %d", actual, expected)
+	}
+	if actual, expected := a.Bytes()[codeSize:], []byte{0x11, 0x12, 0x13, 0x00, 0x00}; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("data and results = %#v, expected %#v", actual, expected)
+	}
+
+	// the second write's compare must read from bus address $7F:FFF0; skip the 7-byte prologue and the first write:
+	offs := 7 + 26 + 11*2 + 2
+	if actual, expected := code[offs:offs+4], []byte{0xAF, 0xF0, 0xFF, 0x7F}; !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("compare instruction = %#v, expected %#v", actual, expected)
+	}
+}
+
+func TestGenerateCompareCopyAsm_split(t *testing.T) {
+	d := make([]byte, 20)
+	writes := make([]devices.MemoryWriteRequest, 4)
+	for i := range writes {
+		writes[i] = devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:      0xF50000 + uint32(i*0x100),
+				AddressSpace: sni.AddressSpace_FxPakPro,
+			},
+			Data:     d,
+			Expected: d,
+		}
+	}
+
+	// each write needs 26+11*20+20+1 = 267 bytes so only one fits:
+	a := asm.NewEmitter(make([]byte, 512), false)
+	remainder, _, err := GenerateCompareCopyAsm(a, writes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(remainder, writes[1:]) {
+		t.Fatalf("expected remainder of 3 writes; got %d", len(remainder))
+	}
+
+	// a single write too large to ever fit must fail:
+	big := make([]byte, 64)
+	a = asm.NewEmitter(make([]byte, 512), false)
+	_, _, err = GenerateCompareCopyAsm(a, devices.MemoryWriteRequest{
+		RequestAddress: devices.AddressTuple{Address: 0xF50000, AddressSpace: sni.AddressSpace_FxPakPro},
+		Data:           big,
+		Expected:       big,
+	})
+	if err == nil {
+		t.Fatal("expected error for write too large for the snescmd buffer")
+	}
+}
+
+func TestMultiCompareAndSwapMemory_rejectsMixedWrites(t *testing.T) {
+	address := devices.AddressTuple{Address: 0xF50000, AddressSpace: sni.AddressSpace_FxPakPro}
+
+	d := &Device{}
+	_, err := d.MultiCompareAndSwapMemory(
+		context.Background(),
+		devices.MemoryWriteRequest{RequestAddress: address, Data: []byte{0x01}, Expected: []byte{0x00}},
+		devices.MemoryWriteRequest{RequestAddress: address, Data: []byte{0x02}},
+	)
+	var coded *devices.CodedError
+	if !errors.As(err, &coded) || coded.Code != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for mixed conditional and unconditional writes; got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+//go:build darwin && cgo
+
+package fxpakpro
+
+// #cgo LDFLAGS: -framework CoreFoundation -framework IOKit
+// #include <IOKit/IOKitLib.h>
+// #include <IOKit/serial/IOSerialKeys.h>
+// #include <CoreFoundation/CoreFoundation.h>
+// #include <stdlib.h>
+//
+// // usbLocationId finds the serial port whose callout or dial-in device is `path` and returns the `locationID` of the
+// // USB device it belongs to, or 0 if there is none.
+// static uint32_t usbLocationId(const char *path) {
+// 	io_iterator_t iter;
+// 	if (IOServiceGetMatchingServices(kIOMasterPortDefault, IOServiceMatching(kIOSerialBSDServiceValue), &iter) != KERN_SUCCESS) {
+// 		return 0;
+// 	}
+//
+// 	CFStringRef want = CFStringCreateWithCString(kCFAllocatorDefault, path, kCFStringEncodingUTF8);
+// 	uint32_t location = 0;
+// 	io_object_t service;
+// 	while (location == 0 && (service = IOIteratorNext(iter)) != 0) {
+// 		CFTypeRef callout = IORegistryEntryCreateCFProperty(service, CFSTR(kIOCalloutDeviceKey), kCFAllocatorDefault, 0);
+// 		CFTypeRef dialin = IORegistryEntryCreateCFProperty(service, CFSTR(kIODialinDeviceKey), kCFAllocatorDefault, 0);
+// 		if ((callout && CFEqual(callout, want)) || (dialin && CFEqual(dialin, want))) {
+// 			CFTypeRef id = IORegistryEntrySearchCFProperty(service, kIOServicePlane, CFSTR("locationID"),
+// 				kCFAllocatorDefault, kIORegistryIterateRecursively | kIORegistryIterateParents);
+// 			if (id) {
+// 				if (CFGetTypeID(id) != CFNumberGetTypeID() || !CFNumberGetValue((CFNumberRef)id, kCFNumberSInt32Type, &location)) {
+// 					location = 0;
+// 				}
+// 				CFRelease(id);
+// 			}
+// 		}
+// 		if (callout) CFRelease(callout);
+// 		if (dialin) CFRelease(dialin);
+// 		IOObjectRelease(service);
+// 	}
+//
+// 	CFRelease(want);
+// 	IOObjectRelease(iter);
+// 	return location;
+// }
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// usbLocation returns the IOKit `locationID` of the USB device that provides the given serial port, e.g. `14200000`,
+// which encodes the bus and port numbers and stays the same for as long as the device is plugged into the same
+// physical port.
+func usbLocation(portName string) string {
+	path := C.CString(portName)
+	defer C.free(unsafe.Pointer(path))
+
+	location := uint32(C.usbLocationId(path))
+	if location == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%08x", location)
+}
@@ -0,0 +1,27 @@
+//go:build linux
+
+package fxpakpro
+
+import (
+	"path/filepath"
+	"regexp"
+)
+
+// usbPortPath matches sysfs USB device names such as `1-2` or `3-1.4.2`, i.e. bus number and port chain:
+var usbPortPath = regexp.MustCompile(`^\d+-\d+(\.\d+)*$`)
+
+// usbLocation returns the USB topology path of the USB device that provides the given tty, e.g. `1-1.2`, which stays
+// the same for as long as the device is plugged into the same physical port.
+func usbLocation(portName string) string {
+	// e.g. /sys/class/tty/ttyACM0/device -> /sys/devices/pci0000:00/0000:00:14.0/usb1/1-1/1-1.2/1-1.2:1.0
+	iface, err := filepath.EvalSymlinks(filepath.Join("/sys/class/tty", filepath.Base(portName), "device"))
+	if err != nil {
+		return ""
+	}
+
+	location := filepath.Base(filepath.Dir(iface))
+	if !usbPortPath.MatchString(location) {
+		return ""
+	}
+	return location
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !windows && !(darwin && cgo)
+
+package fxpakpro
+
+// usbLocation is not supported on this platform, so devices are only given a stable identifier when they report a
+// unique USB serial number and `usb-` stable URIs never resolve to a device.
+func usbLocation(portName string) string {
+	return ""
+}
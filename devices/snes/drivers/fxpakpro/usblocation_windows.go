@@ -0,0 +1,87 @@
+//go:build windows
+
+package fxpakpro
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+// guidDevClassPorts is the setup class of COM and LPT ports:
+var guidDevClassPorts = windows.GUID{
+	Data1: 0x4D36E978,
+	Data2: 0xE325,
+	Data3: 0x11CE,
+	Data4: [8]byte{0xBF, 0xC1, 0x08, 0x00, 0x2B, 0xE1, 0x03, 0x18},
+}
+
+// usbLocation returns the location path of the USB device that provides the given COM port, e.g.
+// `pciroot0-pci1400-usbroot0-usb2-usbmi0`, which stays the same for as long as the device is plugged into the same
+// physical port.
+func usbLocation(portName string) string {
+	devInfo, err := windows.SetupDiGetClassDevsEx(&guidDevClassPorts, "", 0, windows.DIGCF_PRESENT, 0, "")
+	if err != nil {
+		return ""
+	}
+	defer devInfo.Close()
+
+	for i := 0; ; i++ {
+		data, err := devInfo.EnumDeviceInfo(i)
+		if errors.Is(err, windows.ERROR_NO_MORE_ITEMS) {
+			return ""
+		}
+		if err != nil {
+			continue
+		}
+		if !strings.EqualFold(devicePortName(devInfo, data), portName) {
+			continue
+		}
+
+		// e.g. []string{"PCIROOT(0)#PCI(1400)#USBROOT(0)#USB(2)#USBMI(0)", "ACPI(_SB_)#ACPI(PCI0)#..."}
+		value, err := devInfo.DeviceRegistryProperty(data, windows.SPDRP_LOCATION_PATHS)
+		if err != nil {
+			return ""
+		}
+		paths, ok := value.([]string)
+		if !ok || len(paths) == 0 {
+			return ""
+		}
+		return compactLocationPath(paths[0])
+	}
+}
+
+// devicePortName reads the `COMn` name assigned to a port device from its device registry key.
+func devicePortName(devInfo windows.DevInfo, data *windows.DevInfoData) string {
+	handle, err := devInfo.OpenDevRegKey(data, windows.DICS_FLAG_GLOBAL, 0, windows.DIREG_DEV, windows.KEY_READ)
+	if err != nil {
+		return ""
+	}
+	key := registry.Key(handle)
+	defer key.Close()
+
+	name, _, err := key.GetStringValue("PortName")
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// compactLocationPath turns a location path into a form that can be used in a URI path segment, e.g.
+// `PCIROOT(0)#PCI(1400)#USBROOT(0)#USB(2)` becomes `pciroot0-pci1400-usbroot0-usb2`.
+func compactLocationPath(path string) string {
+	sb := strings.Builder{}
+	for _, c := range strings.ToLower(path) {
+		switch {
+		case c == '#':
+			sb.WriteByte('-')
+		case c == ',':
+			sb.WriteByte('.')
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/devices/snes/mapping"
@@ -56,6 +57,13 @@ func (d *Device) RequiresMemoryMappingForAddress(ctx context.Context, address de
 }
 
 func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryReadRequest) (rsp []devices.MemoryReadResponse, err error) {
+	// check before the connection is closed on error since unsupported memory is not a connection problem:
+	for _, read := range reads {
+		if err = d.checkAccess(read.RequestAddress); err != nil {
+			return
+		}
+	}
+
 	defer func() {
 		if err != nil {
 			rsp = nil
@@ -91,9 +99,8 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
 			domain, addr, offset = mapping.MemoryTypeFor(read.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
+			// checked by checkAccess:
+			domain, _ = bizHawkDomain(domain)
 			_, _ = fmt.Fprintf(sb, "Read|%d|%d|%s\n", offset, read.Size, domain)
 		} else {
 			addressSpace = sni.AddressSpace_SnesABus
@@ -224,6 +231,13 @@ func (d *Device) parseJsonResponse(rspstr []byte) (data []byte, err error) {
 }
 
 func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryWriteRequest) (rsp []devices.MemoryWriteResponse, err error) {
+	// check before the connection is closed on error since unsupported memory is not a connection problem:
+	for _, write := range writes {
+		if err = d.checkAccess(write.RequestAddress); err != nil {
+			return
+		}
+	}
+
 	defer func() {
 		if err != nil {
 			rsp = nil
@@ -251,9 +265,8 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
 			domain, addr, offset = mapping.MemoryTypeFor(write.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
+			// checked by checkAccess:
+			domain, _ = bizHawkDomain(domain)
 			_, _ = fmt.Fprintf(sb, "Write|%d|%s", offset, domain)
 		} else {
 			addressSpace = sni.AddressSpace_SnesABus
@@ -293,3 +306,39 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 
 	return
 }
+
+// bizHawkDomain returns the name of the BizHawk memory domain of the memory type.
+func bizHawkDomain(memoryType mapping.MemoryType) (mapping.MemoryType, error) {
+	switch memoryType {
+	case mapping.MemoryTypeSRAM:
+		return "CARTRAM", nil
+	case mapping.MemoryTypeROM,
+		mapping.MemoryTypeWRAM,
+		mapping.MemoryTypeVRAM,
+		mapping.MemoryTypeAPU,
+		mapping.MemoryTypeCGRAM,
+		mapping.MemoryTypeOAM:
+		return memoryType, nil
+	default:
+		return memoryType, devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: BizHawk has no memory domain for %s memory", memoryType))
+	}
+}
+
+// checkAccess checks that memory at the address can be accessed by the emulator. BizHawk offers a memory domain for
+// each memory type but the register snapshots. The snes9x-rr and Mesen-S connectors only read the SNES A bus, so
+// other memory is deliberately not supported for them.
+func (d *Device) checkAccess(address devices.AddressTuple) (err error) {
+	if d.isBizHawk {
+		memoryType, _, _ := mapping.MemoryTypeFor(address)
+		_, err = bizHawkDomain(memoryType)
+		return
+	}
+
+	if address.AddressSpace != sni.AddressSpace_FxPakPro {
+		return nil
+	}
+	if memoryType, _ := mapping.MemoryTypeForPakAddress(address.Address); !memoryType.IsOnBus() {
+		return devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: %s memory is not supported since only BizHawk offers memory off the SNES A bus", memoryType))
+	}
+	return nil
+}
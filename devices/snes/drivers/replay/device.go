@@ -0,0 +1,353 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sni/devices"
+	"sni/protos/sni"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Device serves a recording back as a virtual device.
+//
+// Each call is matched against the next recorded call, at or after the replay cursor, of the same operation with
+// the same arguments. A match replays the recorded response or error and moves the cursor past it. Memory calls that
+// have no match are served from an image of memory built up from every read and write replayed so far, so that clients
+// keep polling successfully after the recording is exhausted; bytes never seen read as zero. Any other call that has
+// no match replays the most recent matching call before the cursor or fails with NotFound.
+type Device struct {
+	path     string
+	realtime bool
+
+	lock     sync.Mutex
+	closed   bool
+	calls    []devices.RecordedCall
+	requests []string
+	cursor   int
+	memory   map[devices.AddressTuple]byte
+}
+
+// NewDevice creates a device replaying the given calls. If realtime is true, replayed calls take as long as they took
+// when recorded.
+func NewDevice(path string, calls []devices.RecordedCall, realtime bool) *Device {
+	d := &Device{
+		path:     path,
+		realtime: realtime,
+		calls:    calls,
+		requests: make([]string, len(calls)),
+		memory:   make(map[devices.AddressTuple]byte),
+	}
+	for i := range calls {
+		d.requests[i] = requestKey(&calls[i].Request)
+	}
+	return d
+}
+
+// requestKey normalizes a request for comparison using the same encoding as the recording file:
+func requestKey(req *devices.RecordedRequest) string {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (d *Device) ReplayPath() string { return d.path }
+
+func (d *Device) IsClosed() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.closed
+}
+
+func (d *Device) Close() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.closed = true
+	return nil
+}
+
+// replay finds the call matching op and req and returns it, or nil if there is none. If fallback is true, only calls
+// after the cursor are searched so that the caller can serve the request itself; otherwise the most recent matching
+// call before the cursor is returned when nothing matches after it.
+func (d *Device) replay(ctx context.Context, op string, req devices.RecordedRequest, fallback bool) (call *devices.RecordedCall, err error) {
+	key := requestKey(&req)
+
+	d.lock.Lock()
+	for i := d.cursor; i < len(d.calls); i++ {
+		if d.calls[i].Op != op || d.requests[i] != key {
+			continue
+		}
+
+		for ; d.cursor <= i; d.cursor++ {
+			d.apply(&d.calls[d.cursor])
+		}
+		call = &d.calls[i]
+		break
+	}
+	if call == nil && !fallback {
+		for i := d.cursor - 1; i >= 0; i-- {
+			if d.calls[i].Op == op && d.requests[i] == key {
+				call = &d.calls[i]
+				break
+			}
+		}
+	}
+	d.lock.Unlock()
+
+	if call == nil {
+		if !fallback {
+			err = devices.WithCode(codes.NotFound, fmt.Errorf("%s: no recorded %s call matches request", driverName, op))
+		}
+		return
+	}
+
+	if d.realtime {
+		timer := time.NewTimer(call.Duration)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	if call.Err != "" {
+		err = devices.WithCode(call.Code, errors.New(call.Err))
+	}
+	return
+}
+
+// apply updates the memory image with the effects of a replayed call; must be called with d.lock held:
+func (d *Device) apply(call *devices.RecordedCall) {
+	if call.Err != "" {
+		return
+	}
+
+	switch call.Op {
+	case devices.OpMultiReadMemory:
+		for _, rsp := range call.Response.Reads {
+			d.store(rsp.RequestAddress, rsp.Data)
+		}
+	case devices.OpMultiWriteMemory, devices.OpMultiCompareAndSwapMemory:
+		for i, req := range call.Request.Writes {
+			if i < len(call.Response.Writes) && call.Response.Writes[i].CompareFailed {
+				continue
+			}
+			d.store(req.RequestAddress, req.Data)
+		}
+	}
+}
+
+func (d *Device) store(address devices.AddressTuple, data []byte) {
+	for i, b := range data {
+		a := address
+		a.Address += uint32(i)
+		d.memory[a] = b
+	}
+}
+
+func (d *Device) load(address devices.AddressTuple, size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		a := address
+		a.Address += uint32(i)
+		data[i] = d.memory[a]
+	}
+	return data
+}
+
+func (d *Device) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (bool, error) {
+	call, err := d.replay(ctx, devices.OpRequiresMemoryMappingForAddressSpace, devices.RecordedRequest{AddressSpace: addressSpace}, true)
+	if call != nil {
+		return call.Response.Bool, err
+	}
+	return addressSpace != sni.AddressSpace_Raw && addressSpace != sni.AddressSpace_SnesABus, nil
+}
+
+func (d *Device) RequiresMemoryMappingForAddress(ctx context.Context, address devices.AddressTuple) (bool, error) {
+	call, err := d.replay(ctx, devices.OpRequiresMemoryMappingForAddress, devices.RecordedRequest{Address: &address}, true)
+	if call != nil {
+		return call.Response.Bool, err
+	}
+	return address.AddressSpace != sni.AddressSpace_Raw && address.AddressSpace != sni.AddressSpace_SnesABus, nil
+}
+
+func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryReadRequest) ([]devices.MemoryReadResponse, error) {
+	call, err := d.replay(ctx, devices.OpMultiReadMemory, devices.RecordedRequest{Reads: reads}, true)
+	if call != nil {
+		return call.Response.Reads, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	rsps := make([]devices.MemoryReadResponse, 0, len(reads))
+	for _, read := range reads {
+		rsps = append(rsps, devices.MemoryReadResponse{
+			RequestAddress: read.RequestAddress,
+			DeviceAddress:  read.RequestAddress,
+			Data:           d.load(read.RequestAddress, read.Size),
+		})
+	}
+	return rsps, nil
+}
+
+func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryWriteRequest) ([]devices.MemoryWriteResponse, error) {
+	call, err := d.replay(ctx, devices.OpMultiWriteMemory, devices.RecordedRequest{Writes: writes}, true)
+	if call != nil {
+		return call.Response.Writes, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	rsps := make([]devices.MemoryWriteResponse, 0, len(writes))
+	for _, write := range writes {
+		d.store(write.RequestAddress, write.Data)
+		rsps = append(rsps, devices.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress:  write.RequestAddress,
+			Size:           len(write.Data),
+		})
+	}
+	return rsps, nil
+}
+
+func (d *Device) MultiCompareAndSwapMemory(ctx context.Context, writes ...devices.MemoryWriteRequest) ([]devices.MemoryWriteResponse, error) {
+	call, err := d.replay(ctx, devices.OpMultiCompareAndSwapMemory, devices.RecordedRequest{Writes: writes}, true)
+	if call != nil {
+		return call.Response.Writes, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	rsps := make([]devices.MemoryWriteResponse, 0, len(writes))
+	for _, write := range writes {
+		rsp := devices.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress:  write.RequestAddress,
+		}
+		if write.Expected != nil && !bytes.Equal(d.load(write.RequestAddress, len(write.Expected)), write.Expected) {
+			rsp.CompareFailed = true
+		} else {
+			d.store(write.RequestAddress, write.Data)
+			rsp.Size = len(write.Data)
+		}
+		rsps = append(rsps, rsp)
+	}
+	return rsps, nil
+}
+
+func (d *Device) ResetSystem(ctx context.Context) error {
+	_, err := d.replay(ctx, devices.OpResetSystem, devices.RecordedRequest{}, false)
+	return err
+}
+
+func (d *Device) ResetToMenu(ctx context.Context) error {
+	_, err := d.replay(ctx, devices.OpResetToMenu, devices.RecordedRequest{}, false)
+	return err
+}
+
+func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
+	call, err := d.replay(ctx, devices.OpPauseUnpause, devices.RecordedRequest{PausedState: pausedState}, false)
+	if call == nil {
+		return false, err
+	}
+	return call.Response.Bool, err
+}
+
+func (d *Device) PauseToggle(ctx context.Context) error {
+	_, err := d.replay(ctx, devices.OpPauseToggle, devices.RecordedRequest{}, false)
+	return err
+}
+
+func (d *Device) ReadDirectory(ctx context.Context, path string) ([]devices.DirEntry, error) {
+	call, err := d.replay(ctx, devices.OpReadDirectory, devices.RecordedRequest{Path: path}, false)
+	if call == nil {
+		return nil, err
+	}
+	return call.Response.Entries, err
+}
+
+func (d *Device) MakeDirectory(ctx context.Context, path string) error {
+	_, err := d.replay(ctx, devices.OpMakeDirectory, devices.RecordedRequest{Path: path}, false)
+	return err
+}
+
+func (d *Device) RemoveFile(ctx context.Context, path string) error {
+	_, err := d.replay(ctx, devices.OpRemoveFile, devices.RecordedRequest{Path: path}, false)
+	return err
+}
+
+func (d *Device) RenameFile(ctx context.Context, path, newFilename string) error {
+	_, err := d.replay(ctx, devices.OpRenameFile, devices.RecordedRequest{Path: path, NewFilename: newFilename}, false)
+	return err
+}
+
+func (d *Device) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress devices.ProgressReportFunc) (n uint32, err error) {
+	var data []byte
+	data, err = io.ReadAll(io.LimitReader(r, int64(size)))
+	if err != nil {
+		return
+	}
+
+	var call *devices.RecordedCall
+	call, err = d.replay(ctx, devices.OpPutFile, devices.RecordedRequest{Path: path, Size: size, Data: data}, false)
+	if call == nil {
+		return
+	}
+	if progress != nil {
+		progress(call.Response.Size, size)
+	}
+	return call.Response.Size, err
+}
+
+func (d *Device) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived devices.SizeReceivedFunc, progress devices.ProgressReportFunc) (size uint32, err error) {
+	var call *devices.RecordedCall
+	call, err = d.replay(ctx, devices.OpGetFile, devices.RecordedRequest{Path: path}, false)
+	if call == nil {
+		return
+	}
+
+	size = call.Response.Size
+	if sizeReceived != nil {
+		sizeReceived(size)
+	}
+	if _, werr := w.Write(call.Response.Data); werr != nil {
+		return size, werr
+	}
+	if progress != nil {
+		progress(uint32(len(call.Response.Data)), size)
+	}
+	return
+}
+
+func (d *Device) BootFile(ctx context.Context, path string) error {
+	_, err := d.replay(ctx, devices.OpBootFile, devices.RecordedRequest{Path: path}, false)
+	return err
+}
+
+func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) ([]string, error) {
+	call, err := d.replay(ctx, devices.OpFetchFields, devices.RecordedRequest{Fields: fields}, false)
+	if call == nil {
+		return nil, err
+	}
+	return call.Response.Values, err
+}
+
+func (d *Device) NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) ([]map[string]string, []byte, error) {
+	call, err := d.replay(ctx, devices.OpNWACommand, devices.RecordedRequest{Cmd: cmd, Args: args, BinaryArg: binaryArg}, false)
+	if call == nil {
+		return nil, nil, err
+	}
+	return call.Response.AsciiReply, call.Response.BinaryReply, err
+}
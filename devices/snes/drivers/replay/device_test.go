@@ -0,0 +1,287 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+// memoryDevice is a minimal device with memory, a single file and a boot failure to record:
+type memoryDevice struct {
+	memory [0x1000000]byte
+	file   []byte
+}
+
+func (d *memoryDevice) IsClosed() bool { return false }
+func (d *memoryDevice) Close() error   { return nil }
+
+func (d *memoryDevice) RequiresMemoryMappingForAddressSpace(context.Context, sni.AddressSpace) (bool, error) {
+	return false, nil
+}
+func (d *memoryDevice) RequiresMemoryMappingForAddress(context.Context, devices.AddressTuple) (bool, error) {
+	return false, nil
+}
+
+func (d *memoryDevice) MultiReadMemory(_ context.Context, reads ...devices.MemoryReadRequest) (rsps []devices.MemoryReadResponse, err error) {
+	for _, read := range reads {
+		data := make([]byte, read.Size)
+		copy(data, d.memory[read.RequestAddress.Address:])
+		rsps = append(rsps, devices.MemoryReadResponse{RequestAddress: read.RequestAddress, DeviceAddress: read.RequestAddress, Data: data})
+	}
+	return
+}
+
+func (d *memoryDevice) MultiWriteMemory(_ context.Context, writes ...devices.MemoryWriteRequest) (rsps []devices.MemoryWriteResponse, err error) {
+	for _, write := range writes {
+		copy(d.memory[write.RequestAddress.Address:], write.Data)
+		rsps = append(rsps, devices.MemoryWriteResponse{RequestAddress: write.RequestAddress, DeviceAddress: write.RequestAddress, Size: len(write.Data)})
+	}
+	return
+}
+
+func (d *memoryDevice) ResetSystem(context.Context) error                { return nil }
+func (d *memoryDevice) ResetToMenu(context.Context) error                { return nil }
+func (d *memoryDevice) PauseUnpause(context.Context, bool) (bool, error) { return true, nil }
+func (d *memoryDevice) PauseToggle(context.Context) error                { return nil }
+
+func (d *memoryDevice) ReadDirectory(context.Context, string) ([]devices.DirEntry, error) {
+	return []devices.DirEntry{{Name: "game.sfc", Type: sni.DirEntryType_File}}, nil
+}
+func (d *memoryDevice) MakeDirectory(context.Context, string) error      { return nil }
+func (d *memoryDevice) RemoveFile(context.Context, string) error         { return nil }
+func (d *memoryDevice) RenameFile(context.Context, string, string) error { return nil }
+func (d *memoryDevice) PutFile(_ context.Context, _ string, size uint32, r io.Reader, _ devices.ProgressReportFunc) (uint32, error) {
+	var err error
+	d.file, err = io.ReadAll(r)
+	return size, err
+}
+func (d *memoryDevice) GetFile(_ context.Context, _ string, w io.Writer, sizeReceived devices.SizeReceivedFunc, _ devices.ProgressReportFunc) (uint32, error) {
+	sizeReceived(uint32(len(d.file)))
+	_, err := w.Write(d.file)
+	return uint32(len(d.file)), err
+}
+func (d *memoryDevice) BootFile(context.Context, string) error {
+	return devices.WithCode(codes.FailedPrecondition, errors.New("cannot boot"))
+}
+
+func wram(address uint32) devices.AddressTuple {
+	return devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM}
+}
+
+func readByte(t *testing.T, d devices.DeviceMemory, address uint32) byte {
+	t.Helper()
+	rsp, err := d.MultiReadMemory(context.Background(), devices.MemoryReadRequest{RequestAddress: wram(address), Size: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rsp[0].Data[0]
+}
+
+func writeByte(t *testing.T, d devices.DeviceMemory, address uint32, value byte) {
+	t.Helper()
+	if _, err := d.MultiWriteMemory(context.Background(), devices.MemoryWriteRequest{RequestAddress: wram(address), Data: []byte{value}}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	uri := &url.URL{Scheme: "memory", Opaque: "test"}
+
+	// record a session made through a device opened while recording is enabled:
+	dir := t.TempDir()
+	config.Config.Set("record_enable", true)
+	config.Config.Set("record_dir", dir)
+	t.Cleanup(func() {
+		config.Config.Set("record_enable", false)
+		config.Config.Set("record_dir", "")
+	})
+
+	real := &memoryDevice{}
+	real.memory[0x7E0010] = 1
+	container := devices.NewDeviceDriverContainer(func(*url.URL) (devices.Device, error) { return real, nil })
+	rec := devices.NewAutoCloseableDevice(container, uri, "test")
+
+	if got := readByte(t, rec, 0x7E0010); got != 1 {
+		t.Fatalf("recorded read = %d", got)
+	}
+	real.memory[0x7E0010] = 2
+	if got := readByte(t, rec, 0x7E0010); got != 2 {
+		t.Fatalf("recorded read = %d", got)
+	}
+	writeByte(t, rec, 0x7E0020, 0x55)
+	if _, err := rec.PutFile(ctx, "/game.sfc", 3, bytes.NewReader([]byte{1, 2, 3}), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.BootFile(ctx, "/game.sfc"); !snestest.IsCode(err, codes.FailedPrecondition) {
+		t.Fatalf("recorded boot error = %v", err)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "memory-test-*.jsonl"))
+	if err != nil || len(paths) != 1 {
+		t.Fatalf("recordings = %v, %v; expected one", paths, err)
+	}
+	path := paths[0]
+
+	// replay it:
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, calls, err := devices.ReadRecording(f)
+	_ = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Uri != uri.String() {
+		t.Fatalf("header uri = %q", header.Uri)
+	}
+	if len(calls) != 5 {
+		t.Fatalf("recorded %d calls, expected 5", len(calls))
+	}
+
+	d := NewDevice(path, calls, false)
+	if _, ok := devices.Device(d).(devices.Replayer); !ok {
+		t.Fatal("replay device must not be recorded")
+	}
+
+	// recorded responses are replayed in order:
+	if got := readByte(t, d, 0x7E0010); got != 1 {
+		t.Fatalf("replayed read = %d, expected 1", got)
+	}
+	if got := readByte(t, d, 0x7E0010); got != 2 {
+		t.Fatalf("replayed read = %d, expected 2", got)
+	}
+	// once exhausted, reads are served from the last known memory contents:
+	if got := readByte(t, d, 0x7E0010); got != 2 {
+		t.Fatalf("read after recording = %d, expected 2", got)
+	}
+	writeByte(t, d, 0x7E0020, 0x55)
+	if got := readByte(t, d, 0x7E0020); got != 0x55 {
+		t.Fatalf("read of written memory = %#x, expected 0x55", got)
+	}
+	// unrecorded writes update the memory image:
+	writeByte(t, d, 0x7E0030, 0xAA)
+	if got := readByte(t, d, 0x7E0030); got != 0xAA {
+		t.Fatalf("read of unrecorded write = %#x, expected 0xaa", got)
+	}
+
+	// recorded errors are replayed with their codes:
+	if err = d.BootFile(ctx, "/game.sfc"); !snestest.IsCode(err, codes.FailedPrecondition) {
+		t.Fatalf("replayed boot error = %v", err)
+	}
+	// calls never recorded are not found:
+	if err = d.BootFile(ctx, "/other.sfc"); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("unrecorded boot error = %v", err)
+	}
+	if err = d.ResetSystem(ctx); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("unrecorded reset error = %v", err)
+	}
+}
+
+// TestReplayThroughAutoCloseableDevice replays reads that are merged with each other or polled for WatchMemory when
+// recorded, which must arrive at the replay device as they were recorded:
+func TestReplayThroughAutoCloseableDevice(t *testing.T) {
+	dir := t.TempDir()
+	config.Config.Set("record_enable", true)
+	config.Config.Set("record_dir", dir)
+	t.Cleanup(func() {
+		config.Config.Set("record_enable", false)
+		config.Config.Set("record_dir", "")
+	})
+
+	reads := []devices.MemoryReadRequest{
+		{RequestAddress: wram(0x7E0040), Size: 1},
+		{RequestAddress: wram(0x7E0041), Size: 1},
+	}
+	watched := devices.MemoryReadRequest{RequestAddress: wram(0x7E0050), Size: 1}
+
+	real := &memoryDevice{}
+	real.memory[0x7E0040] = 0x11
+	real.memory[0x7E0041] = 0x22
+	real.memory[0x7E0050] = 0x33
+	container := devices.NewDeviceDriverContainer(func(*url.URL) (devices.Device, error) { return real, nil })
+	rec := devices.NewAutoCloseableDevice(container, &url.URL{Scheme: "memory", Opaque: "merged"}, "merged")
+
+	check := func(name string, d devices.AutoCloseableDevice) {
+		t.Helper()
+		rsps, err := d.MultiReadMemory(context.Background(), reads...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rsps) != 2 || !bytes.Equal(rsps[0].Data, []byte{0x11}) || !bytes.Equal(rsps[1].Data, []byte{0x22}) {
+			t.Fatalf("%s two-range read = %+v", name, rsps)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events, err := d.WatchMemory(ctx, 0, watched)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := <-events
+		if ev.Err != nil {
+			t.Fatal(ev.Err)
+		}
+		if !bytes.Equal(ev.Responses[0].Data, []byte{0x33}) {
+			t.Fatalf("%s watched read = %v", name, ev.Responses[0].Data)
+		}
+	}
+
+	check("recorded", rec)
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "memory-merged-*.jsonl"))
+	if err != nil || len(paths) != 1 {
+		t.Fatalf("recordings = %v, %v; expected one", paths, err)
+	}
+	f, err := os.Open(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, calls, err := devices.ReadRecording(f)
+	_ = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Config.Set("record_enable", false)
+	replayed := NewDevice(paths[0], calls, false)
+	replayContainer := devices.NewDeviceDriverContainer(func(*url.URL) (devices.Device, error) { return replayed, nil })
+	uri := uriForPath(paths[0])
+	check("replayed", devices.NewAutoCloseableDevice(replayContainer, &uri, paths[0]))
+}
+
+func TestPathForUri(t *testing.T) {
+	for _, path := range []string{"/tmp/session.jsonl", filepath.Join(t.TempDir(), "a b.jsonl")} {
+		uri := uriForPath(path)
+		parsed, err := url.Parse(uri.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pathForUri(parsed); got != path {
+			t.Errorf("pathForUri(%q) = %q, expected %q", uri.String(), got, path)
+		}
+	}
+
+	uri := &url.URL{Scheme: driverName, Path: "/C:/recordings/session.jsonl"}
+	if got := pathForUri(uri); got != filepath.FromSlash("C:/recordings/session.jsonl") {
+		t.Errorf("windows path = %q", got)
+	}
+}
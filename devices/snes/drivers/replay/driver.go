@@ -0,0 +1,176 @@
+package replay
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+const driverName = "replay"
+
+const recordingExt = ".jsonl"
+
+type Driver struct {
+	container devices.DeviceContainer
+}
+
+var driver *Driver
+
+func (d *Driver) DisplayOrder() int {
+	return 1001
+}
+
+func (d *Driver) DisplayName() string {
+	return "Replay"
+}
+
+func (d *Driver) DisplayDescription() string {
+	return "Replay a recorded device session for testing"
+}
+
+func (d *Driver) Kind() string { return "replay" }
+
+// a recording may come from any driver so all capabilities are declared; calls that were never recorded fail with
+// NotFound:
+var driverCapabilities = []sni.DeviceCapability{
+	sni.DeviceCapability_ReadMemory,
+	sni.DeviceCapability_WriteMemory,
+	sni.DeviceCapability_ResetSystem,
+	sni.DeviceCapability_ResetToMenu,
+	sni.DeviceCapability_PauseUnpauseEmulation,
+	sni.DeviceCapability_PauseToggleEmulation,
+	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_ReadDirectory,
+	sni.DeviceCapability_MakeDirectory,
+	sni.DeviceCapability_RemoveFile,
+	sni.DeviceCapability_RenameFile,
+	sni.DeviceCapability_PutFile,
+	sni.DeviceCapability_GetFile,
+	sni.DeviceCapability_BootFile,
+	sni.DeviceCapability_NWACommand,
+}
+
+func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
+	return devices.CheckCapabilities(capabilities, driverCapabilities)
+}
+
+// replayDir returns the directory to find recordings in:
+func replayDir() string {
+	dir := config.Config.GetString("replay_dir")
+	if dir == "" {
+		dir = devices.RecordingDir()
+	}
+	return dir
+}
+
+func (d *Driver) Detect() (descriptors []devices.DeviceDescriptor, err error) {
+	dir := replayDir()
+
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+
+	descriptors = make([]devices.DeviceDescriptor, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), recordingExt) {
+			continue
+		}
+
+		descriptors = append(descriptors, devices.DeviceDescriptor{
+			Uri:                 uriForPath(filepath.Join(dir, entry.Name())),
+			DisplayName:         fmt.Sprintf("Replay %s", strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))),
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: sni.AddressSpace_SnesABus,
+			System:              "snes",
+		})
+	}
+
+	return
+}
+
+func uriForPath(path string) url.URL {
+	p := filepath.ToSlash(path)
+	if !strings.HasPrefix(p, "/") {
+		// e.g. "C:/Users/..." on Windows:
+		p = "/" + p
+	}
+	return url.URL{Scheme: driverName, Path: p}
+}
+
+var windowsDrivePath = regexp.MustCompile(`^/[A-Za-z]:/`)
+
+// pathForUri returns the recording file path for either "replay:///abs/path.jsonl" or "replay:rel/path.jsonl":
+func pathForUri(uri *url.URL) string {
+	p := uri.Path
+	if uri.Opaque != "" {
+		p = uri.Opaque
+	} else if windowsDrivePath.MatchString(p) {
+		p = p[1:]
+	}
+	return filepath.FromSlash(p)
+}
+
+func (d *Driver) openDevice(uri *url.URL) (devices.Device, error) {
+	path := pathForUri(uri)
+	if path == "" {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("%s: missing recording path in uri '%s'", driverName, uri))
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, devices.WithCode(codes.NotFound, fmt.Errorf("%s: %w", driverName, err))
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	header, calls, err := devices.ReadRecording(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", driverName, path, err)
+	}
+
+	log.Printf("%s: replaying %d calls recorded from '%s' at %s\n", driverName, len(calls), header.Uri, header.Started)
+	return NewDevice(path, calls, uri.Query().Get("realtime") == "1"), nil
+}
+
+func (d *Driver) Device(uri *url.URL) devices.AutoCloseableDevice {
+	return devices.NewAutoCloseableDevice(d.container, uri, d.DeviceKey(uri))
+}
+
+func (d *Driver) DeviceKey(uri *url.URL) string { return pathForUri(uri) }
+
+func (d *Driver) DisconnectAll() {
+	for _, deviceKey := range d.container.AllDeviceKeys() {
+		device, ok := d.container.GetDevice(deviceKey)
+		if ok {
+			log.Printf("%s: disconnecting device '%s'\n", driverName, deviceKey)
+			_ = device.Close()
+			d.container.DeleteDevice(deviceKey)
+		}
+	}
+}
+
+func DriverInit() {
+	if config.Config.GetBool("replay_enable") {
+		log.Printf("enabling replay snes driver\n")
+		driver = &Driver{}
+		driver.container = devices.NewDeviceDriverContainer(driver.openDevice)
+		devices.Register(driverName, driver)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"log"
 	"net"
 	"sni/cmd/sni/config"
@@ -380,6 +381,19 @@ func (c *RAClient) RequiresMemoryMappingForAddress(ctx context.Context, address
 	return true, nil
 }
 
+// requireBusAccess checks that memory at the address can be accessed via the SNES A bus. RetroArch's network commands
+// only reach the memory map the core exposes for the SNES A bus, so this driver deliberately does not support VRAM,
+// APU RAM, CGRAM, OAM or the register snapshots of the FX Pak Pro address space.
+func requireBusAccess(address devices.AddressTuple) error {
+	if address.AddressSpace != sni.AddressSpace_FxPakPro {
+		return nil
+	}
+	if memoryType, _ := mapping.MemoryTypeForPakAddress(address.Address); !memoryType.IsOnBus() {
+		return devices.WithCode(codes.Unimplemented, fmt.Errorf("retroarch: %s memory is not supported since RetroArch network commands only reach the SNES A bus", memoryType))
+	}
+	return nil
+}
+
 func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryReadRequest) (mrsp []devices.MemoryReadResponse, err error) {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -400,6 +414,9 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 			Data: make([]byte, 0, read.Size),
 		}
 
+		if err = requireBusAccess(read.RequestAddress); err != nil {
+			return nil, err
+		}
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
 			read.RequestAddress,
 			sni.AddressSpace_SnesABus,
@@ -521,6 +538,9 @@ func (c *RAClient) MultiWriteMemory(ctx context.Context, writes ...devices.Memor
 			Size: 0,
 		}
 
+		if err = requireBusAccess(write.RequestAddress); err != nil {
+			return nil, err
+		}
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
 			write.RequestAddress,
 			sni.AddressSpace_SnesABus,
@@ -0,0 +1,72 @@
+// Package gamedb identifies the game loaded on a device by matching its ROM hashes, or failing that its ROM header
+// title, against No-Intro or other Logiqx XML DAT files.
+package gamedb
+
+import (
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Game is a game entry of a DAT file.
+type Game struct {
+	Name        string `xml:"name,attr"`
+	Description string `xml:"description"`
+	Roms        []Rom  `xml:"rom"`
+}
+
+// Rom is a ROM file of a game. Hashes are hex strings as found in the DAT.
+type Rom struct {
+	Name  string `xml:"name,attr"`
+	Size  uint64 `xml:"size,attr"`
+	CRC32 string `xml:"crc,attr"`
+	MD5   string `xml:"md5,attr"`
+	SHA1  string `xml:"sha1,attr"`
+}
+
+type datafile struct {
+	Name  string `xml:"header>name"`
+	Games []Game `xml:"game"`
+	// MAME style DATs name their games machines:
+	Machines []Game `xml:"machine"`
+}
+
+// Parse reads a Logiqx XML DAT file and returns its name and games.
+func Parse(r io.Reader) (name string, games []Game, err error) {
+	var d datafile
+	if err = xml.NewDecoder(r).Decode(&d); err != nil {
+		return
+	}
+	return d.Name, append(d.Games, d.Machines...), nil
+}
+
+var (
+	tagPattern      = regexp.MustCompile(`\(([^()]*)\)`)
+	revisionPattern = regexp.MustCompile(`^Rev ([0-9A-Za-z.]+)$`)
+)
+
+// Title returns the name of the game without any of the No-Intro tags in parentheses.
+func (g *Game) Title() string {
+	title, _, _ := strings.Cut(g.Name, " (")
+	return strings.TrimSpace(title)
+}
+
+// Region returns the first tag of a No-Intro name, which lists the regions the game was released in e.g. "Japan, USA".
+func (g *Game) Region() string {
+	tags := tagPattern.FindAllStringSubmatch(g.Name, -1)
+	if len(tags) == 0 {
+		return ""
+	}
+	return tags[0][1]
+}
+
+// Revision returns the revision from the "(Rev 1)" tag of a No-Intro name, or "0" for the original release.
+func (g *Game) Revision() string {
+	for _, tag := range tagPattern.FindAllStringSubmatch(g.Name, -1) {
+		if m := revisionPattern.FindStringSubmatch(tag[1]); m != nil {
+			return m[1]
+		}
+	}
+	return "0"
+}
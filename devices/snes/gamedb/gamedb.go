@@ -0,0 +1,295 @@
+package gamedb
+
+import (
+	"context"
+	"fmt"
+	"github.com/alttpo/snes"
+	"log"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
+	"sni/protos/sni"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Dir returns the directory DAT files are loaded from:
+func Dir() string {
+	return filepath.Join(config.Dir, "dats")
+}
+
+// MatchedBy is how a game was matched.
+type MatchedBy string
+
+const (
+	MatchedBySHA1  MatchedBy = "sha1"
+	MatchedByMD5   MatchedBy = "md5"
+	MatchedByCRC32 MatchedBy = "crc32"
+	// MatchedByTitle is a match of only the title in the ROM header, which may be a different release or a hack:
+	MatchedByTitle MatchedBy = "title"
+)
+
+// Match is a game identified from a DAT file.
+type Match struct {
+	Game *Game
+	// Dat is the name of the DAT file the game was found in:
+	Dat string
+	By  MatchedBy
+}
+
+type entry struct {
+	game *Game
+	dat  string
+}
+
+// Database indexes the games of DAT files by the hashes of their ROMs and by their titles.
+type Database struct {
+	bySHA1  map[string]entry
+	byMD5   map[string]entry
+	byCRC32 map[string]entry
+	byTitle map[string][]entry
+}
+
+func NewDatabase() *Database {
+	return &Database{
+		bySHA1:  make(map[string]entry),
+		byMD5:   make(map[string]entry),
+		byCRC32: make(map[string]entry),
+		byTitle: make(map[string][]entry),
+	}
+}
+
+// normalizeTitle reduces a title to lowercase letters and digits so that "SUPER METROID" in a ROM header matches
+// "Super Metroid" in a DAT.
+func normalizeTitle(title string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// Add indexes the games of the named DAT. The first game added for a hash wins.
+func (d *Database) Add(dat string, games []Game) {
+	add := func(index map[string]entry, hash string, e entry) {
+		if hash == "" {
+			return
+		}
+		hash = strings.ToLower(hash)
+		if _, ok := index[hash]; !ok {
+			index[hash] = e
+		}
+	}
+
+	for i := range games {
+		e := entry{game: &games[i], dat: dat}
+		for _, rom := range games[i].Roms {
+			add(d.bySHA1, rom.SHA1, e)
+			add(d.byMD5, rom.MD5, e)
+			add(d.byCRC32, rom.CRC32, e)
+		}
+		if title := normalizeTitle(games[i].Title()); title != "" {
+			d.byTitle[title] = append(d.byTitle[title], e)
+		}
+	}
+}
+
+// Empty reports whether no games were added.
+func (d *Database) Empty() bool {
+	return len(d.byTitle) == 0 && len(d.bySHA1) == 0 && len(d.byMD5) == 0 && len(d.byCRC32) == 0
+}
+
+// regionNames are the No-Intro names of the regions of ROM header destination codes:
+var regionNames = map[snes.Region]string{
+	snes.RegionJapan:             "Japan",
+	snes.RegionNorthAmerica:      "USA",
+	snes.RegionEurope:            "Europe",
+	snes.RegionSwedenScandinavia: "Sweden",
+	snes.RegionFinland:           "Finland",
+	snes.RegionDenmark:           "Denmark",
+	snes.RegionFrance:            "France",
+	snes.RegionNetherlands:       "Netherlands",
+	snes.RegionSpain:             "Spain",
+	snes.RegionGermany:           "Germany",
+	snes.RegionItaly:             "Italy",
+	snes.RegionChina:             "China",
+	snes.RegionKorea:             "Korea",
+	snes.RegionCanada:            "Canada",
+	snes.RegionBrazil:            "Brazil",
+	snes.RegionAustralia:         "Australia",
+}
+
+func (e entry) inRegion(region string) bool {
+	for _, r := range strings.Split(e.game.Region(), ",") {
+		r = strings.TrimSpace(r)
+		if r == region || r == "World" {
+			return true
+		}
+	}
+	return false
+}
+
+// filter keeps the entries matching keep unless none would be left.
+func filter(entries []entry, keep func(e entry) bool) []entry {
+	var kept []entry
+	for _, e := range entries {
+		if keep(e) {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) == 0 {
+		return entries
+	}
+	return kept
+}
+
+// Lookup finds the game with a ROM matching the hashes by SHA-1, MD5 or CRC32. Failing that, it finds a game with the
+// same title as the ROM header, preferring the release for the header's region and version; Match.By tells them apart.
+func (d *Database) Lookup(h *romhash.Hashes, header []byte) (m Match, ok bool) {
+	if e, ok := d.bySHA1[h.SHA1String()]; ok {
+		return Match{Game: e.game, Dat: e.dat, By: MatchedBySHA1}, true
+	}
+	if e, ok := d.byMD5[h.MD5String()]; ok {
+		return Match{Game: e.game, Dat: e.dat, By: MatchedByMD5}, true
+	}
+	if e, ok := d.byCRC32[h.CRC32String()]; ok {
+		return Match{Game: e.game, Dat: e.dat, By: MatchedByCRC32}, true
+	}
+
+	info, err := mapping.ParseRomInfo(header)
+	if err != nil {
+		return
+	}
+	candidates := d.byTitle[normalizeTitle(info.Title)]
+	if len(candidates) == 0 {
+		return
+	}
+	if region, ok := regionNames[info.Region]; ok {
+		candidates = filter(candidates, func(e entry) bool { return e.inRegion(region) })
+	}
+	version := strconv.Itoa(int(info.Version))
+	candidates = filter(candidates, func(e entry) bool { return e.game.Revision() == version })
+
+	return Match{Game: candidates[0].game, Dat: candidates[0].dat, By: MatchedByTitle}, true
+}
+
+var (
+	loadedMu  sync.Mutex
+	loaded    *Database
+	loadedKey string
+)
+
+// Load returns the database of all ".dat" and ".xml" files in Dir. The files are only read again when one is added,
+// removed or modified. Files that cannot be parsed are skipped.
+func Load() (d *Database, err error) {
+	var dirEntries []os.DirEntry
+	dirEntries, err = os.ReadDir(Dir())
+	if os.IsNotExist(err) {
+		return NewDatabase(), nil
+	}
+	if err != nil {
+		return
+	}
+
+	var files []string
+	var key strings.Builder
+	for _, dirEntry := range dirEntries {
+		ext := strings.ToLower(filepath.Ext(dirEntry.Name()))
+		if dirEntry.IsDir() || (ext != ".dat" && ext != ".xml") {
+			continue
+		}
+		info, ierr := dirEntry.Info()
+		if ierr != nil {
+			continue
+		}
+		files = append(files, dirEntry.Name())
+		_, _ = fmt.Fprintf(&key, "%s|%d|%d\n", dirEntry.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	sort.Strings(files)
+
+	loadedMu.Lock()
+	defer loadedMu.Unlock()
+
+	if loaded != nil && loadedKey == key.String() {
+		return loaded, nil
+	}
+
+	d = NewDatabase()
+	for _, file := range files {
+		f, oerr := os.Open(filepath.Join(Dir(), file))
+		if oerr != nil {
+			log.Printf("gamedb: skipping %s: %v\n", file, oerr)
+			continue
+		}
+		name, games, perr := Parse(f)
+		_ = f.Close()
+		if perr != nil {
+			log.Printf("gamedb: skipping %s: %v\n", file, perr)
+			continue
+		}
+		if name == "" {
+			name = strings.TrimSuffix(file, filepath.Ext(file))
+		}
+		d.Add(name, games)
+		log.Printf("gamedb: loaded %d games from %s\n", len(games), file)
+	}
+
+	loaded, loadedKey = d, key.String()
+	return
+}
+
+// FillFields sets the values of game fields that the driver left empty by looking up the ROM loaded on the device in
+// the DAT files. The ROM is only hashed if such a field is present and DAT files are loaded. The fields are left
+// empty if the game is not found.
+func FillFields(ctx context.Context, memory devices.DeviceMemory, uri string, fields []sni.Field, values []string) (err error) {
+	needed := false
+	for i, field := range fields {
+		switch field {
+		case sni.Field_GameName, sni.Field_GameRegion, sni.Field_GameRevision, sni.Field_GameMatch:
+			needed = needed || values[i] == ""
+		}
+	}
+	if !needed {
+		return
+	}
+
+	var d *Database
+	if d, err = Load(); err != nil || d.Empty() {
+		return
+	}
+
+	var h romhash.Hashes
+	var header []byte
+	if h, header, err = romhash.ForDevice(ctx, memory, uri); err != nil {
+		return
+	}
+
+	m, ok := d.Lookup(&h, header)
+	if !ok {
+		return
+	}
+
+	for i, field := range fields {
+		if values[i] != "" {
+			continue
+		}
+		switch field {
+		case sni.Field_GameName:
+			values[i] = m.Game.Name
+		case sni.Field_GameRegion:
+			values[i] = m.Game.Region()
+		case sni.Field_GameRevision:
+			values[i] = m.Game.Revision()
+		case sni.Field_GameMatch:
+			values[i] = string(m.By)
+		}
+	}
+	return
+}
@@ -0,0 +1,148 @@
+package gamedb
+
+import (
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices/snes/romhash"
+	"strings"
+	"testing"
+)
+
+const testDat = `<?xml version="1.0"?>
+<!DOCTYPE datafile PUBLIC "-//Logiqx//DTD ROM Management Datafile//EN" "http://www.logiqx.com/Dats/datafile.dtd">
+<datafile>
+	<header>
+		<name>Nintendo - Super Nintendo Entertainment System</name>
+	</header>
+	<game name="Super Metroid (Japan, USA) (En,Ja)">
+		<description>Super Metroid (Japan, USA) (En,Ja)</description>
+		<rom name="Super Metroid (Japan, USA) (En,Ja).sfc" size="3145728" crc="D63ED5F8" md5="21F3E98DF4780EE1C667B84E57D88675" sha1="DA957F0D63D14CB441D215462904C4FA8519C613"/>
+	</game>
+	<game name="Super Metroid (Europe) (En,Fr,De)">
+		<description>Super Metroid (Europe) (En,Fr,De)</description>
+		<rom name="Super Metroid (Europe) (En,Fr,De).sfc" size="3145728" crc="A4E5BE3F" sha1="..."/>
+	</game>
+	<game name="Legend of Zelda, The - A Link to the Past (USA)">
+		<rom name="Legend of Zelda, The - A Link to the Past (USA).sfc" size="1048576" crc="777AAC2F"/>
+	</game>
+	<game name="Test Game (USA)">
+		<rom name="Test Game (USA).sfc" size="524288" crc="00000001"/>
+	</game>
+	<game name="Test Game (USA) (Rev 1)">
+		<rom name="Test Game (USA) (Rev 1).sfc" size="524288" crc="00000002"/>
+	</game>
+	<game name="Test Game (Japan)">
+		<rom name="Test Game (Japan).sfc" size="524288" crc="00000003"/>
+	</game>
+</datafile>
+`
+
+func useTempDir(t *testing.T) {
+	oldDir := config.Dir
+	config.Dir = t.TempDir()
+	t.Cleanup(func() { config.Dir = oldDir })
+}
+
+// header returns a ROM header with the given title, destination code and version:
+func header(title string, region byte, version byte) []byte {
+	h := make([]byte, 0x50)
+	copy(h[0x10:0x25], strings.Repeat(" ", 21))
+	copy(h[0x10:0x25], title)
+	h[0x29] = region
+	h[0x2A] = 0x33
+	h[0x2B] = version
+	return h
+}
+
+func TestGameTags(t *testing.T) {
+	for _, tc := range []struct {
+		name, title, region, revision string
+	}{
+		{"Super Metroid (Japan, USA) (En,Ja)", "Super Metroid", "Japan, USA", "0"},
+		{"Test Game (USA) (Rev 1)", "Test Game", "USA", "1"},
+		{"Chrono Trigger (USA) (Rev A) (Beta)", "Chrono Trigger", "USA", "A"},
+		{"Untagged", "Untagged", "", "0"},
+	} {
+		g := Game{Name: tc.name}
+		if g.Title() != tc.title || g.Region() != tc.region || g.Revision() != tc.revision {
+			t.Errorf("%q: got %q, %q, %q; want %q, %q, %q", tc.name, g.Title(), g.Region(), g.Revision(), tc.title, tc.region, tc.revision)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	name, games, err := Parse(strings.NewReader(testDat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Nintendo - Super Nintendo Entertainment System" || len(games) != 6 {
+		t.Fatalf("unexpected DAT %q with %d games", name, len(games))
+	}
+
+	d := NewDatabase()
+	d.Add(name, games)
+
+	tests := []struct {
+		name     string
+		hashes   romhash.Hashes
+		header   []byte
+		wantGame string
+		wantBy   MatchedBy
+	}{
+		{"by crc32", romhash.Hashes{CRC32: 0x777AAC2F}, nil, "Legend of Zelda, The - A Link to the Past (USA)", MatchedByCRC32},
+		{"by sha1", romhash.Hashes{SHA1: [20]byte{0xDA, 0x95, 0x7F, 0x0D, 0x63, 0xD1, 0x4C, 0xB4, 0x41, 0xD2, 0x15, 0x46, 0x29, 0x04, 0xC4, 0xFA, 0x85, 0x19, 0xC6, 0x13}}, nil, "Super Metroid (Japan, USA) (En,Ja)", MatchedBySHA1},
+		{"by title and region", romhash.Hashes{}, header("SUPER METROID", 0x02, 0), "Super Metroid (Europe) (En,Fr,De)", MatchedByTitle},
+		{"by title for multiple regions", romhash.Hashes{}, header("SUPER METROID", 0x00, 0), "Super Metroid (Japan, USA) (En,Ja)", MatchedByTitle},
+		{"by title and revision", romhash.Hashes{}, header("TEST GAME", 0x01, 1), "Test Game (USA) (Rev 1)", MatchedByTitle},
+		{"by title and original release", romhash.Hashes{}, header("TEST GAME", 0x01, 0), "Test Game (USA)", MatchedByTitle},
+		{"by title with unknown revision", romhash.Hashes{}, header("TEST GAME", 0x00, 3), "Test Game (Japan)", MatchedByTitle},
+		{"not found", romhash.Hashes{}, header("UNKNOWN", 0x01, 0), "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := d.Lookup(&tt.hashes, tt.header)
+			if !ok {
+				if tt.wantGame != "" {
+					t.Fatalf("Lookup() found nothing, want %q", tt.wantGame)
+				}
+				return
+			}
+			if m.Game.Name != tt.wantGame || m.By != tt.wantBy || m.Dat != name {
+				t.Errorf("Lookup() = %q, %q; want %q, %q", m.Game.Name, m.By, tt.wantGame, tt.wantBy)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	useTempDir(t)
+
+	d, err := Load()
+	if err != nil || !d.Empty() {
+		t.Fatalf("expected an empty database without a dats directory: %v", err)
+	}
+
+	if err = os.MkdirAll(Dir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(Dir(), "snes.dat"), []byte(testDat), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// broken and unrelated files are skipped:
+	_ = os.WriteFile(filepath.Join(Dir(), "broken.xml"), []byte("<datafile><game"), 0644)
+	_ = os.WriteFile(filepath.Join(Dir(), "readme.txt"), []byte("not a dat"), 0644)
+
+	d, err = Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, ok := d.Lookup(&romhash.Hashes{CRC32: 0xD63ED5F8}, nil); !ok || m.Game.Name != "Super Metroid (Japan, USA) (En,Ja)" {
+		t.Fatalf("expected game to be found in loaded DAT: %+v", m)
+	}
+
+	// unchanged files are not read again:
+	if again, _ := Load(); again != d {
+		t.Fatal("expected the loaded database to be reused")
+	}
+}
@@ -0,0 +1,73 @@
+// Package bsx translates addresses for BS-X (Satellaview) memory pack games.
+//
+// The FX Pak Pro stores the memory pack (flash) contents at $00_0000, the 512KiB of PSRAM at $40_0000, the BS-X BIOS
+// at $80_0000 and the 32KiB of battery-backed base unit SRAM at $E0_0000. The MCC chip of the BS-X cartridge can remap
+// flash and PSRAM at runtime; only the layout the BIOS sets up for a LoROM memory pack game is modeled here:
+//
+//	$00-$3F,$80-$BF:8000-FFFF = flash (LoROM)
+//	$C0-$CF:0000-FFFF         = flash (HiROM)
+//	$10-$17:5000-5FFF         = base unit SRAM
+//	$70-$77:0000-FFFF         = PSRAM
+//	$7E-$7F:0000-FFFF         = WRAM
+package bsx
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+const (
+	FlashPakAddress = 0x00_0000
+	FlashSize       = 0x10_0000
+	PSRAMPakAddress = 0x40_0000
+	PSRAMSize       = 0x08_0000
+	BIOSPakAddress  = 0x80_0000
+	BIOSSize        = 0x10_0000
+	SRAMPakAddress  = 0xE0_0000
+	SRAMSize        = 0x00_8000
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank >= 0x7E && bank <= 0x7F {
+		// WRAM access:        $7E:0000-$7F:FFFF
+		return (busAddr - 0x7E0000) + 0xF50000, nil
+	} else if bank >= 0x70 && bank <= 0x77 {
+		// PSRAM access:       $70:0000-$77:FFFF
+		return ((bank - 0x70) << 16) + offs + PSRAMPakAddress, nil
+	} else if bank >= 0xC0 && bank <= 0xCF {
+		// HiROM flash access: $C0:0000-$CF:FFFF
+		return ((bank - 0xC0) << 16) + offs + FlashPakAddress, nil
+	} else if bank&0x7F < 0x40 {
+		if offs&0x8000 != 0 {
+			// LoROM flash access: $00:8000-$3F:FFFF
+			return (util.BankToLinear(busAddr&0x3F7FFF) & (FlashSize - 1)) + FlashPakAddress, nil
+		} else if bank&0x78 == 0x10 && offs >= 0x5000 && offs < 0x6000 {
+			// SRAM access:        $10:5000-$17:5FFF
+			return ((bank&0x07)<<12 | offs&0x0FFF) + SRAMPakAddress, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			return offs + 0xF50000, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		return ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000, nil
+	} else if pakAddr >= SRAMPakAddress && pakAddr < SRAMPakAddress+SRAMSize {
+		// SRAM is split into 4KiB pages at $5000 of banks $10-$17:
+		offs := pakAddr - SRAMPakAddress
+		return ((0x10 + offs>>12) << 16) + 0x5000 + offs&0x0FFF, nil
+	} else if pakAddr >= PSRAMPakAddress && pakAddr < PSRAMPakAddress+PSRAMSize {
+		return (pakAddr - PSRAMPakAddress) + 0x700000, nil
+	} else if pakAddr >= FlashPakAddress && pakAddr < FlashPakAddress+FlashSize {
+		// flash is presented the same way as LoROM ROM:
+		offs := pakAddr - FlashPakAddress
+		return ((0x80 + offs>>15) << 16) + (offs & 0x7FFF) | 0x8000, nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,75 @@
+package bsx
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"flash header", 0x00FFC0, 0x007FC0, false},
+		{"flash header fastrom", 0x80FFC0, 0x007FC0, false},
+		{"flash bank $1F", 0x1F8000, 0x0F8000, false},
+		{"flash mirror bank $20", 0x208000, 0x000000, false},
+		{"flash hirom", 0xC12345, 0x012345, false},
+		{"sram bank $10", 0x105000, 0xE00000, false},
+		{"sram bank $17", 0x175FFF, 0xE07FFF, false},
+		{"sram fastrom mirror", 0x905123, 0xE00123, false},
+		{"psram", 0x700000, 0x400000, false},
+		{"psram end", 0x77FFFF, 0x47FFFF, false},
+		{"wram", 0x7E0010, 0xF50010, false},
+		{"wram low mirror", 0x001FFF, 0xF51FFF, false},
+		{"mcc registers", 0x015000, 0, true},
+		{"hardware registers", 0x002100, 0, true},
+		{"unmapped psram mirror", 0x780000, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak($%06x) error = %v, wantErr %v", tt.busAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, want $%06x", tt.busAddr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"flash header", 0x007FC0, 0x80FFC0, false},
+		{"flash end", 0x0FFFFF, 0x9FFFFF, false},
+		{"psram", 0x412345, 0x712345, false},
+		{"sram", 0xE01234, 0x115234, false},
+		{"wram", 0xF50010, 0x7E0010, false},
+		{"bios", 0x800000, 0, true},
+		{"past flash", 0x100000, 0, true},
+		{"past sram", 0xE08000, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus($%06x) error = %v, wantErr %v", tt.pakAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus($%06x) = $%06x, want $%06x", tt.pakAddr, got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			// every mapped pak address must map back to itself:
+			if back, err := BusAddressToPak(got); err != nil || back != tt.pakAddr {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, %v; want $%06x", got, back, err, tt.pakAddr)
+			}
+		})
+	}
+}
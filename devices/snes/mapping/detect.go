@@ -61,6 +61,28 @@ func Detect(
 
 	confidence = true
 
+	// BS-X memory pack headers share the location of the standard header but keep their map mode at $28:
+	if isBSXHeader(outHeaderBytes) {
+		mapping = sni.MemoryMapping_BSX
+		log.Printf(
+			"detect: BS-X map mode = %02x; detected mapping mode = %s\n",
+			outHeaderBytes[0x28],
+			sni.MemoryMapping_name[int32(mapping)],
+		)
+		return
+	}
+
+	// coprocessors that bring their own memory mapping are identified by the chipset byte:
+	if coprocessorMapping, ok := detectCoprocessor(&header); ok {
+		mapping = coprocessorMapping
+		log.Printf(
+			"detect: chipset = %02x; detected mapping mode = %s\n",
+			header.CartridgeType,
+			sni.MemoryMapping_name[int32(mapping)],
+		)
+		return
+	}
+
 	// mask off SlowROM vs FastROM bit:
 	switch header.MapMode & 0b1110_1111 {
 	case 0x20: // LoROM
@@ -68,7 +90,12 @@ func Detect(
 	case 0x21: // HiROM
 		mapping = sni.MemoryMapping_HiROM
 	case 0x22: // ExLoROM
-		mapping = sni.MemoryMapping_LoROM
+		// only ROMs larger than 4MiB need the upper banks remapped; smaller ones are mapped just like LoROM:
+		if header.ROMSizeBytes() > 0x40_0000 {
+			mapping = sni.MemoryMapping_ExLoROM
+		} else {
+			mapping = sni.MemoryMapping_LoROM
+		}
 	case 0x23: // SA-1
 		mapping = sni.MemoryMapping_SA1
 	case 0x25: // ExHiROM
@@ -101,17 +128,65 @@ func Detect(
 	return
 }
 
+// detectCoprocessor detects carts whose coprocessor changes the memory mapping from the one their map mode suggests.
+// The upper nibble of the chipset byte selects the coprocessor while the lower nibble describes the RAM and battery.
+func detectCoprocessor(header *snes.Header) (mapping sni.MemoryMapping, ok bool) {
+	switch header.CartridgeType >> 4 {
+	case 0x1: // SuperFX (GSU), with a LoROM map mode
+		if header.CartridgeType&0x0F >= 0x03 {
+			return sni.MemoryMapping_SuperFX, true
+		}
+	case 0x4: // S-DD1, with an ExLoROM map mode
+		if header.CartridgeType&0x0F >= 0x03 {
+			return sni.MemoryMapping_SDD1, true
+		}
+	case 0xF: // custom chip identified by the map mode and sub-type
+		if header.MapMode&0b1110_1111 == 0x2A && header.CoCPUType == 0x00 {
+			return sni.MemoryMapping_SPC7110, true
+		}
+	}
+	return sni.MemoryMapping_Unknown, false
+}
+
+// isBSXHeader checks the fields of a BS-X memory pack header that differ from a standard ROM header: the limited
+// starts flags at $25, the broadcast date at $26-$27 and the map mode at $28, where a standard header has its SRAM size.
+func isBSXHeader(h []byte) bool {
+	if len(h) < 0x30 {
+		return false
+	}
+	if h[0x2A] != 0x33 && h[0x2A] != 0xFF {
+		return false
+	}
+	if h[0x25] != 0 && h[0x25]&0x83 != 0x80 {
+		return false
+	}
+	// LoROM or HiROM, SlowROM or FastROM:
+	if h[0x28]&0b1110_1110 != 0x20 {
+		return false
+	}
+
+	// the month and day are stored in the upper bits of their bytes and may be left blank:
+	month, day := h[0x26], h[0x27]
+	if (month == 0x00 && day == 0x00) || (month == 0xFF && day == 0xFF) {
+		return true
+	}
+	return month&0x0F == 0 && month>>4 >= 1 && month>>4 <= 12 &&
+		day&0x03 == 0 && day>>3 >= 1 && day>>3 <= 31
+}
+
 func detectHeader(ctx context.Context, memory devices.DeviceMemory) (outHeaderBytes []byte, err error) {
-	addresses := [3]uint32{
+	addresses := [4]uint32{
 		uint32(0x007FB0),
 		uint32(0x00FFB0),
 		uint32(0x40FFB0),
+		uint32(0x407FB0),
 	}
 	mappings := []sni.MemoryMapping{
 		sni.MemoryMapping_LoROM,
 		sni.MemoryMapping_HiROM,
 		sni.MemoryMapping_ExHiROM,
 		sni.MemoryMapping_SA1,
+		sni.MemoryMapping_ExLoROM,
 	}
 
 	bestScore := 0
@@ -154,6 +229,10 @@ func detectHeader(ctx context.Context, memory devices.DeviceMemory) (outHeaderBy
 				return
 			}
 			score := header.Score(address)
+			// Score only expects ExLoROM headers at $007FB0 but the CPU reads them from bank $00 at $407FB0:
+			if address == 0x407FB0 && header.MapMode&0b1110_1111 == 0x22 {
+				score += 2
+			}
 
 			log.Printf(
 				"detect: read {address:%s,deviceAddress:%s,size:$%x} complete: score=%d\n%s",
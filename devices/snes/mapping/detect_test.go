@@ -0,0 +1,118 @@
+package mapping
+
+import (
+	"context"
+	"encoding/binary"
+	"sni/devices"
+	"sni/devices/snes/mapping/exlorom"
+	"sni/protos/sni"
+	"testing"
+)
+
+func header(mapModeAt0x25, b26, b27, b28 byte) []byte {
+	h := make([]byte, 0x50)
+	copy(h[0x10:0x20], "SATELLAVIEW GAME")
+	h[0x25] = mapModeAt0x25
+	h[0x26] = b26
+	h[0x27] = b27
+	h[0x28] = b28
+	h[0x2A] = 0x33
+	binary.LittleEndian.PutUint16(h[0x2C:], 0xEDCB)
+	binary.LittleEndian.PutUint16(h[0x2E:], 0x1234)
+	for i := 0x30; i < 0x50; i += 2 {
+		binary.LittleEndian.PutUint16(h[i:], 0x8000)
+	}
+	return h
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name           string
+		header         []byte
+		wantMapping    sni.MemoryMapping
+		wantConfidence bool
+	}{
+		{"lorom", header(0x20, 0x02, 0x0A, 0x03), sni.MemoryMapping_LoROM, true},
+		{"hirom fastrom", header(0x31, 0x02, 0x0A, 0x03), sni.MemoryMapping_HiROM, true},
+		{"sa1", header(0x23, 0x35, 0x0A, 0x03), sni.MemoryMapping_SA1, true},
+		{"exhirom", header(0x25, 0x02, 0x0C, 0x05), sni.MemoryMapping_ExHiROM, true},
+		// ExLoROM: $0D ROM size is 8MiB, $0C is 4MiB:
+		{"exlorom", header(0x32, 0x02, 0x0D, 0x03), sni.MemoryMapping_ExLoROM, true},
+		{"exlorom 4MiB", header(0x22, 0x02, 0x0C, 0x03), sni.MemoryMapping_LoROM, true},
+		// coprocessors by chipset byte:
+		{"superfx star fox", header(0x20, 0x13, 0x08, 0x00), sni.MemoryMapping_SuperFX, true},
+		{"superfx yoshi's island", header(0x20, 0x15, 0x0B, 0x00), sni.MemoryMapping_SuperFX, true},
+		{"sdd1 star ocean", header(0x32, 0x45, 0x0D, 0x03), sni.MemoryMapping_SDD1, true},
+		{"spc7110", header(0x3A, 0xF9, 0x0D, 0x03), sni.MemoryMapping_SPC7110, true},
+		{"dsp is not a coprocessor mapping", header(0x20, 0x03, 0x0A, 0x00), sni.MemoryMapping_LoROM, true},
+		// BS-X: limited starts, month 4 day 23, LoROM:
+		{"bsx lorom", header(0x80, 0x40, 23<<3, 0x20), sni.MemoryMapping_BSX, true},
+		{"bsx hirom fastrom", header(0x00, 0x40, 23<<3, 0x31), sni.MemoryMapping_BSX, true},
+		{"bsx undated", header(0x00, 0x00, 0x00, 0x20), sni.MemoryMapping_BSX, true},
+		// not BS-X: invalid month, so the byte at $25 decides:
+		{"bsx invalid month", header(0x20, 0xD0, 23<<3, 0x20), sni.MemoryMapping_LoROM, true},
+		// not BS-X: SRAM size byte instead of map mode at $28:
+		{"standard with blank date", header(0x21, 0x00, 0x00, 0x01), sni.MemoryMapping_HiROM, true},
+		{"unknown", header(0x00, 0x00, 0x00, 0x01), sni.MemoryMapping_LoROM, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMapping, gotConfidence, _, err := Detect(context.Background(), nil, nil, tt.header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotMapping != tt.wantMapping || gotConfidence != tt.wantConfidence {
+				t.Errorf("Detect() = %s, %v; want %s, %v", gotMapping, gotConfidence, tt.wantMapping, tt.wantConfidence)
+			}
+		})
+	}
+}
+
+// romMemory is an emulator's SNES A-bus with the given ROM loaded using an ExLoROM mapping:
+type romMemory struct {
+	rom []byte
+}
+
+func (m *romMemory) RequiresMemoryMappingForAddressSpace(context.Context, sni.AddressSpace) (bool, error) {
+	return true, nil
+}
+
+func (m *romMemory) RequiresMemoryMappingForAddress(context.Context, devices.AddressTuple) (bool, error) {
+	return true, nil
+}
+
+func (m *romMemory) MultiReadMemory(_ context.Context, reads ...devices.MemoryReadRequest) (rsps []devices.MemoryReadResponse, err error) {
+	for _, read := range reads {
+		var busAddress uint32
+		busAddress, err = TranslateAddress(read.RequestAddress, sni.AddressSpace_SnesABus)
+		if err != nil {
+			return
+		}
+		var pakAddress uint32
+		pakAddress, err = exlorom.BusAddressToPak(busAddress)
+		if err != nil {
+			return
+		}
+		data := make([]byte, read.Size)
+		copy(data, m.rom[pakAddress:])
+		rsps = append(rsps, devices.MemoryReadResponse{RequestAddress: read.RequestAddress, Data: data})
+	}
+	return
+}
+
+func (m *romMemory) MultiWriteMemory(context.Context, ...devices.MemoryWriteRequest) ([]devices.MemoryWriteResponse, error) {
+	panic("not implemented")
+}
+
+func TestDetectExLoROMHeader(t *testing.T) {
+	m := &romMemory{rom: make([]byte, 0x80_0000)}
+	copy(m.rom[0x407FB0:], header(0x32, 0x02, 0x0D, 0x03))
+
+	gotMapping, gotConfidence, _, err := Detect(context.Background(), m, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotMapping != sni.MemoryMapping_ExLoROM || !gotConfidence {
+		t.Errorf("Detect() = %s, %v; want %s, true", gotMapping, gotConfidence, sni.MemoryMapping_ExLoROM)
+	}
+}
@@ -0,0 +1,62 @@
+// Package exlorom translates addresses for ExLoROM carts of more than 4MiB (up to 8MiB) of ROM.
+//
+// ExLoROM is LoROM with the address line A23 inverted for ROM: the first 4MiB of ROM is mapped in banks $80-$FF and
+// the upper 4MiB in banks $00-$7D. The ROM header and vectors are therefore found at $40_7FB0 in ROM.
+//
+//	$80-$FF:8000-FFFF = ROM $00_0000-$3F_FFFF
+//	$00-$7D:8000-FFFF = ROM $40_0000-$7E_FFFF
+//	$70-$7D,$F0-$FF:0000-7FFF = SRAM
+//	$40-$6F,$C0-$EF:0000-7FFF = ROM mirror of $8000-FFFF
+package exlorom
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+// romToLinear maps a bus address within ROM to its linear ROM address.
+func romToLinear(busAddr uint32) uint32 {
+	return util.BankToLinear((busAddr ^ 0x800000) & 0xFF7FFF)
+}
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank == 0x7E || bank == 0x7F {
+		// WRAM access:        $7E:0000-$7F:FFFF
+		return (busAddr - 0x7E0000) + 0xF50000, nil
+	} else if offs&0x8000 != 0 {
+		// ROM access:         $00:8000-$7D:FFFF, $80:8000-$FF:FFFF
+		return romToLinear(busAddr) + 0x000000, nil
+	} else if bank&0x7F >= 0x70 {
+		// SRAM access:        $70:0000-$7D:7FFF, $F0:0000-$FF:7FFF
+		return util.BankToLinear(busAddr&0x0F7FFF) + 0xE00000, nil
+	} else if bank&0x7F >= 0x40 {
+		// ROM mirror access:  $40:0000-$6F:7FFF, $C0:0000-$EF:7FFF
+		return romToLinear(busAddr) + 0x000000, nil
+	} else if offs < 0x2000 {
+		// Lower 8KiB of WRAM: $00:0000-$3F:1FFF, $80:0000-$BF:1FFF
+		return offs + 0xF50000, nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		return ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000, nil
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xF00000 {
+		// SRAM:                 $70:0000-$7D:7FFF, then $FE:0000-$FF:7FFF like LoROM
+		offs := (pakAddr - 0xE00000) & 0x07FFFF
+		bank := offs >> 15
+		if bank < 0x0E {
+			return ((0x70 + bank) << 16) + (offs & 0x7FFF), nil
+		}
+		return ((0xF0 + bank) << 16) + (offs & 0x7FFF), nil
+	} else if pakAddr < 0x7F0000 {
+		// ROM; banks $7E and $7F would be WRAM so the last 64KiB of 8MiB cannot be reached:
+		bank := ((pakAddr >> 15) ^ 0x80) & 0xFF
+		return (bank << 16) + (pakAddr & 0x7FFF) | 0x8000, nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,82 @@
+package exlorom
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"rom bank $80", 0x808000, 0x000000, false},
+		{"rom bank $BF", 0xBFFFFF, 0x1FFFFF, false},
+		{"rom bank $C0", 0xC08000, 0x200000, false},
+		{"rom bank $FF", 0xFFFFFF, 0x3FFFFF, false},
+		{"rom bank $C0 mirror", 0xC00000, 0x200000, false},
+		{"header bank $00", 0x00FFB0, 0x407FB0, false},
+		{"rom bank $3F", 0x3FFFFF, 0x5FFFFF, false},
+		{"rom bank $40", 0x408000, 0x600000, false},
+		{"rom bank $40 mirror", 0x400000, 0x600000, false},
+		{"rom bank $6F", 0x6FFFFF, 0x77FFFF, false},
+		{"rom bank $7D", 0x7DFFFF, 0x7EFFFF, false},
+		{"sram bank $70", 0x700010, 0xE00010, false},
+		{"sram bank $7D", 0x7D7FFF, 0xE6FFFF, false},
+		{"sram bank $F0", 0xF00010, 0xE00010, false},
+		{"wram", 0x7E0010, 0xF50010, false},
+		{"wram bank $7F", 0x7FFFFF, 0xF6FFFF, false},
+		{"wram low mirror", 0x801FFF, 0xF51FFF, false},
+		{"hardware registers", 0x002100, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak($%06x) error = %v, wantErr %v", tt.busAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, want $%06x", tt.busAddr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"rom start", 0x000000, 0x808000, false},
+		{"rom 2MiB", 0x200000, 0xC08000, false},
+		{"rom 4MiB", 0x3FFFFF, 0xFFFFFF, false},
+		{"header", 0x407FB0, 0x00FFB0, false},
+		{"rom 6MiB", 0x600000, 0x408000, false},
+		{"rom last reachable", 0x7EFFFF, 0x7DFFFF, false},
+		{"rom behind wram", 0x7F0000, 0, true},
+		{"sram", 0xE00010, 0x700010, false},
+		{"sram bank $7D", 0xE6FFFF, 0x7D7FFF, false},
+		{"sram bank $FE", 0xE70000, 0xFE0000, false},
+		{"wram", 0xF50010, 0x7E0010, false},
+		{"gap", 0xF00000, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus($%06x) error = %v, wantErr %v", tt.pakAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus($%06x) = $%06x, want $%06x", tt.pakAddr, got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			// every mapped pak address must map back to itself:
+			if back, err := BusAddressToPak(got); err != nil || back != tt.pakAddr {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, %v; want $%06x", got, back, err, tt.pakAddr)
+			}
+		})
+	}
+}
@@ -6,6 +6,11 @@ import (
 	"github.com/alttpo/snes/mapping/lorom"
 	"github.com/alttpo/snes/mapping/sa1rom"
 	"sni/devices"
+	"sni/devices/snes/mapping/bsx"
+	"sni/devices/snes/mapping/exlorom"
+	"sni/devices/snes/mapping/sdd1"
+	"sni/devices/snes/mapping/spc7110"
+	"sni/devices/snes/mapping/superfx"
 	"sni/protos/sni"
 )
 
@@ -17,8 +22,29 @@ const (
 	MemoryTypeROM     MemoryType = "CARTROM"
 	MemoryTypeSRAM    MemoryType = "SRAM"
 	MemoryTypeWRAM    MemoryType = "WRAM"
+	MemoryTypeVRAM    MemoryType = "VRAM"
+	MemoryTypeAPU     MemoryType = "APURAM"
+	MemoryTypeCGRAM   MemoryType = "CGRAM"
+	MemoryTypeOAM     MemoryType = "OAM"
+	MemoryTypeMISC    MemoryType = "MISC"
+	MemoryTypePPUREG  MemoryType = "PPUREG"
+	MemoryTypeCPUREG  MemoryType = "CPUREG"
+	// BS-X memory pack flash and PSRAM:
+	MemoryTypeFlash MemoryType = "FLASH"
+	MemoryTypePSRAM MemoryType = "PSRAM"
 )
 
+// IsOnBus reports whether the memory type can be accessed via the SNES A bus. VRAM, APU RAM, CGRAM and OAM are only
+// reachable through PPU and APU ports, so devices that only offer bus access cannot read them.
+func (t MemoryType) IsOnBus() bool {
+	switch t {
+	case MemoryTypeROM, MemoryTypeSRAM, MemoryTypeWRAM, MemoryTypeFlash, MemoryTypePSRAM:
+		return true
+	default:
+		return false
+	}
+}
+
 func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress uint32, offset uint32) {
 	var err error
 
@@ -36,6 +62,16 @@ func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress ui
 			pakAddress, err = exhirom.BusAddressToPak(a.Address)
 		case sni.MemoryMapping_SA1:
 			pakAddress, err = sa1rom.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_BSX:
+			pakAddress, err = bsx.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_ExLoROM:
+			pakAddress, err = exlorom.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SuperFX:
+			pakAddress, err = superfx.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SDD1:
+			pakAddress, err = sdd1.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SPC7110:
+			pakAddress, err = spc7110.BusAddressToPak(a.Address)
 		}
 	case sni.AddressSpace_Raw:
 		err = ErrUnknownMapping
@@ -47,10 +83,32 @@ func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress ui
 		return
 	}
 
+	if a.MemoryMapping == sni.MemoryMapping_BSX {
+		memoryType, offset = MemoryTypeForBSXPakAddress(pakAddress)
+		return
+	}
+
 	memoryType, offset = MemoryTypeForPakAddress(pakAddress)
 	return
 }
 
+// MemoryTypeForBSXPakAddress is MemoryTypeForPakAddress for a BS-X cartridge where the ROM area instead holds the
+// memory pack flash, PSRAM and the BIOS.
+func MemoryTypeForBSXPakAddress(pakAddress uint32) (memoryType MemoryType, offset uint32) {
+	if pakAddress >= bsx.FlashPakAddress && pakAddress < bsx.FlashPakAddress+bsx.FlashSize {
+		memoryType, offset = MemoryTypeFlash, pakAddress-bsx.FlashPakAddress
+	} else if pakAddress >= bsx.PSRAMPakAddress && pakAddress < bsx.PSRAMPakAddress+bsx.PSRAMSize {
+		memoryType, offset = MemoryTypePSRAM, pakAddress-bsx.PSRAMPakAddress
+	} else if pakAddress >= bsx.BIOSPakAddress && pakAddress < bsx.BIOSPakAddress+bsx.BIOSSize {
+		memoryType, offset = MemoryTypeROM, pakAddress-bsx.BIOSPakAddress
+	} else if pakAddress < 0xE0_0000 {
+		memoryType, offset = MemoryTypeUnknown, pakAddress
+	} else {
+		memoryType, offset = MemoryTypeForPakAddress(pakAddress)
+	}
+	return
+}
+
 func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset uint32) {
 	if pakAddress < 0xE0_0000 {
 		memoryType, offset = MemoryTypeROM, pakAddress
@@ -61,9 +119,22 @@ func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset u
 		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF0_0000
 	} else if pakAddress < 0xF7_0000 {
 		memoryType, offset = MemoryTypeWRAM, pakAddress-0xF5_0000
+	} else if pakAddress < 0xF8_0000 {
+		memoryType, offset = MemoryTypeVRAM, pakAddress-0xF7_0000
+	} else if pakAddress < 0xF9_0000 {
+		memoryType, offset = MemoryTypeAPU, pakAddress-0xF8_0000
+	} else if pakAddress < 0xF9_0200 {
+		memoryType, offset = MemoryTypeCGRAM, pakAddress-0xF9_0000
+	} else if pakAddress < 0xF9_0420 {
+		memoryType, offset = MemoryTypeOAM, pakAddress-0xF9_0200
+	} else if pakAddress < 0xF9_0500 {
+		memoryType, offset = MemoryTypeMISC, pakAddress-0xF9_0420
+	} else if pakAddress < 0xF9_0700 {
+		memoryType, offset = MemoryTypePPUREG, pakAddress-0xF9_0500
+	} else if pakAddress < 0xF9_0900 {
+		memoryType, offset = MemoryTypeCPUREG, pakAddress-0xF9_0700
 	} else {
-		// TODO: VRAM, APU, CGRAM, OAM, MISC, PPUREG, CPUREG, etc.
-		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF7_0000
+		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF9_0900
 	}
 	return
 }
@@ -0,0 +1,77 @@
+package mapping
+
+import (
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestMemoryTypeForPakAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		pakAddress     uint32
+		wantMemoryType MemoryType
+		wantOffset     uint32
+		wantOnBus      bool
+	}{
+		{"rom", 0x00_7FC0, MemoryTypeROM, 0x7FC0, true},
+		{"sram", 0xE0_0010, MemoryTypeSRAM, 0x10, true},
+		{"gap", 0xF0_0000, MemoryTypeUnknown, 0, false},
+		{"wram", 0xF5_0010, MemoryTypeWRAM, 0x10, true},
+		{"wram end", 0xF6_FFFF, MemoryTypeWRAM, 0x1_FFFF, true},
+		{"vram", 0xF7_1234, MemoryTypeVRAM, 0x1234, false},
+		{"apu", 0xF8_00F4, MemoryTypeAPU, 0xF4, false},
+		{"cgram", 0xF9_01FF, MemoryTypeCGRAM, 0x1FF, false},
+		{"oam", 0xF9_0200, MemoryTypeOAM, 0, false},
+		{"oam high table", 0xF9_041F, MemoryTypeOAM, 0x21F, false},
+		{"misc", 0xF9_0420, MemoryTypeMISC, 0, false},
+		{"ppureg", 0xF9_0534, MemoryTypePPUREG, 0x34, false},
+		{"cpureg", 0xF9_0700, MemoryTypeCPUREG, 0, false},
+		{"past cpureg", 0xF9_0900, MemoryTypeUnknown, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMemoryType, gotOffset := MemoryTypeForPakAddress(tt.pakAddress)
+			if gotMemoryType != tt.wantMemoryType || gotOffset != tt.wantOffset {
+				t.Errorf("MemoryTypeForPakAddress($%06x) = %s, $%x; want %s, $%x", tt.pakAddress, gotMemoryType, gotOffset, tt.wantMemoryType, tt.wantOffset)
+			}
+			if gotOnBus := gotMemoryType.IsOnBus(); gotOnBus != tt.wantOnBus {
+				t.Errorf("IsOnBus() = %v, want %v", gotOnBus, tt.wantOnBus)
+			}
+		})
+	}
+}
+
+func TestMemoryTypeForBSX(t *testing.T) {
+	tests := []struct {
+		name           string
+		address        uint32
+		addressSpace   sni.AddressSpace
+		wantMemoryType MemoryType
+		wantPakAddress uint32
+		wantOffset     uint32
+	}{
+		{"flash", 0x80FFC0, sni.AddressSpace_SnesABus, MemoryTypeFlash, 0x007FC0, 0x7FC0},
+		{"psram", 0x701234, sni.AddressSpace_SnesABus, MemoryTypePSRAM, 0x401234, 0x1234},
+		{"sram", 0x115234, sni.AddressSpace_SnesABus, MemoryTypeSRAM, 0xE01234, 0x1234},
+		{"wram", 0x7E0010, sni.AddressSpace_SnesABus, MemoryTypeWRAM, 0xF50010, 0x10},
+		{"unmapped", 0x015000, sni.AddressSpace_SnesABus, MemoryTypeUnknown, 0, 0},
+		{"pak flash", 0x0F0000, sni.AddressSpace_FxPakPro, MemoryTypeFlash, 0x0F0000, 0x0F0000},
+		{"pak psram", 0x47FFFF, sni.AddressSpace_FxPakPro, MemoryTypePSRAM, 0x47FFFF, 0x7FFFF},
+		{"pak bios", 0x807FC0, sni.AddressSpace_FxPakPro, MemoryTypeROM, 0x807FC0, 0x7FC0},
+		{"pak gap", 0x200000, sni.AddressSpace_FxPakPro, MemoryTypeUnknown, 0x200000, 0x200000},
+		{"pak vram", 0xF70000, sni.AddressSpace_FxPakPro, MemoryTypeVRAM, 0xF70000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMemoryType, gotPakAddress, gotOffset := MemoryTypeFor(devices.AddressTuple{
+				Address:       tt.address,
+				AddressSpace:  tt.addressSpace,
+				MemoryMapping: sni.MemoryMapping_BSX,
+			})
+			if gotMemoryType != tt.wantMemoryType || gotPakAddress != tt.wantPakAddress || gotOffset != tt.wantOffset {
+				t.Errorf("MemoryTypeFor($%06x) = %s, $%06x, $%x; want %s, $%06x, $%x", tt.address, gotMemoryType, gotPakAddress, gotOffset, tt.wantMemoryType, tt.wantPakAddress, tt.wantOffset)
+			}
+		})
+	}
+}
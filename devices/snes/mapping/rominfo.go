@@ -0,0 +1,130 @@
+package mapping
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/alttpo/snes"
+	"strings"
+)
+
+// RomInfo is a ROM header parsed into its fields.
+type RomInfo struct {
+	Title   string
+	MapMode byte
+	FastROM bool
+	Chipset byte
+	// ChipsetName describes the chipset byte e.g. "ROM+SuperFX+RAM+battery":
+	ChipsetName string
+	// sizes are in bytes as declared by the header; 0 if absent or invalid:
+	ROMSize          uint32
+	SRAMSize         uint32
+	ExpansionRAMSize uint32
+	Region           snes.Region
+	RegionName       string
+	// DeveloperID is $33 if the two character MakerCode is used instead:
+	DeveloperID        byte
+	MakerCode          string
+	Version            byte
+	Checksum           uint16
+	ChecksumComplement uint16
+	// ChecksumComplementValid only checks that the checksum and its complement agree; the ROM contents are not summed:
+	ChecksumComplementValid bool
+}
+
+// ParseRomInfo parses the ROM header read from $00:FFB0.
+func ParseRomInfo(headerBytes []byte) (info RomInfo, err error) {
+	if len(headerBytes) < 0x30 {
+		err = fmt.Errorf("input ROM header must be at least $30 bytes")
+		return
+	}
+
+	header := snes.Header{}
+	err = header.ReadHeader(bytes.NewReader(headerBytes))
+	if err != nil {
+		return
+	}
+
+	info = RomInfo{
+		Title:                   strings.TrimRight(string(header.Title[:]), " \x00"),
+		MapMode:                 header.MapMode,
+		FastROM:                 header.MapMode&0x10 != 0,
+		Chipset:                 header.CartridgeType,
+		ChipsetName:             chipsetName(&header),
+		ROMSize:                 sizeBytes(header.ROMSize),
+		SRAMSize:                sizeBytes(header.RAMSize),
+		Region:                  header.DestinationCode,
+		RegionName:              snes.RegionNames[header.DestinationCode],
+		DeveloperID:             header.OldMakerCode,
+		Version:                 header.MaskROMVersion,
+		Checksum:                header.CheckSum,
+		ChecksumComplement:      header.ComplementCheckSum,
+		ChecksumComplementValid: header.CheckSum^header.ComplementCheckSum == 0xFFFF,
+	}
+	if header.HeaderVersion() == 3 {
+		info.MakerCode = strings.TrimRight(string([]byte{byte(header.MakerCode), byte(header.MakerCode >> 8)}), " \x00")
+	}
+	if header.HeaderVersion() >= 2 {
+		info.ExpansionRAMSize = sizeBytes(header.ExpansionRAMSize)
+	}
+	return
+}
+
+// sizeBytes converts a header size of 1KiB << n bytes into bytes.
+func sizeBytes(n byte) uint32 {
+	if n == 0 || n > 0x0F {
+		return 0
+	}
+	return 1024 << n
+}
+
+var coprocessorNames = map[byte]string{
+	0x0: "DSP",
+	0x1: "SuperFX",
+	0x2: "OBC1",
+	0x3: "SA-1",
+	0x4: "S-DD1",
+	0x5: "S-RTC",
+	0xE: "Other",
+}
+
+var customCoprocessorNames = map[byte]string{
+	0x00: "SPC7110",
+	0x01: "ST010/ST011",
+	0x02: "ST018",
+	0x10: "CX4",
+}
+
+// chipsetName describes the chipset byte: the lower nibble lists the parts on the cart and the upper nibble names
+// the coprocessor, if any.
+func chipsetName(header *snes.Header) string {
+	coprocessor, ok := coprocessorNames[header.CartridgeType>>4]
+	if header.CartridgeType>>4 == 0xF {
+		coprocessor, ok = customCoprocessorNames[header.CoCPUType]
+	}
+	if !ok {
+		coprocessor = fmt.Sprintf("coprocessor $%X", header.CartridgeType>>4)
+	}
+
+	switch header.CartridgeType & 0x0F {
+	case 0x0:
+		return "ROM"
+	case 0x1:
+		return "ROM+RAM"
+	case 0x2:
+		return "ROM+RAM+battery"
+	case 0x3:
+		return "ROM+" + coprocessor
+	case 0x4:
+		return "ROM+" + coprocessor + "+RAM"
+	case 0x5:
+		return "ROM+" + coprocessor + "+RAM+battery"
+	case 0x6:
+		return "ROM+" + coprocessor + "+battery"
+	case 0x9:
+		return "ROM+" + coprocessor + "+RAM+battery+RTC"
+	case 0xA:
+		return "ROM+" + coprocessor + "+RAM+battery"
+	default:
+		return fmt.Sprintf("unknown chipset $%02X", header.CartridgeType)
+	}
+}
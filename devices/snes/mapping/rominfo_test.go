@@ -0,0 +1,111 @@
+package mapping
+
+import (
+	"testing"
+)
+
+func TestParseRomInfo(t *testing.T) {
+	// header(mapMode, chipset, romSize, sramSize) has title "SATELLAVIEW GAME", developer ID $33 and a valid checksum:
+	withBytes := func(h []byte, offsetsAndValues ...int) []byte {
+		for i := 0; i < len(offsetsAndValues); i += 2 {
+			h[offsetsAndValues[i]] = byte(offsetsAndValues[i+1])
+		}
+		return h
+	}
+
+	tests := []struct {
+		name    string
+		header  []byte
+		want    RomInfo
+		wantErr bool
+	}{
+		{
+			name:   "lorom with sram and maker code",
+			header: withBytes(header(0x20, 0x02, 0x0A, 0x03), 0x00, '0', 0x01, '1', 0x29, 0x01, 0x2B, 0x02),
+			want: RomInfo{
+				Title:                   "SATELLAVIEW GAME",
+				MapMode:                 0x20,
+				Chipset:                 0x02,
+				ChipsetName:             "ROM+RAM+battery",
+				ROMSize:                 0x10_0000,
+				SRAMSize:                0x2000,
+				Region:                  0x01,
+				RegionName:              "North America",
+				DeveloperID:             0x33,
+				MakerCode:               "01",
+				Version:                 0x02,
+				Checksum:                0x1234,
+				ChecksumComplement:      0xEDCB,
+				ChecksumComplementValid: true,
+			},
+		},
+		{
+			name:   "superfx with expansion ram",
+			header: withBytes(header(0x20, 0x15, 0x0B, 0x00), 0x0D, 0x05),
+			want: RomInfo{
+				Title:                   "SATELLAVIEW GAME",
+				MapMode:                 0x20,
+				Chipset:                 0x15,
+				ChipsetName:             "ROM+SuperFX+RAM+battery",
+				ROMSize:                 0x20_0000,
+				ExpansionRAMSize:        0x8000,
+				RegionName:              "Japan",
+				DeveloperID:             0x33,
+				Checksum:                0x1234,
+				ChecksumComplement:      0xEDCB,
+				ChecksumComplementValid: true,
+			},
+		},
+		{
+			name:   "fastrom spc7110",
+			header: withBytes(header(0x3A, 0xF9, 0x0D, 0x03), 0x0F, 0x00),
+			want: RomInfo{
+				Title:                   "SATELLAVIEW GAME",
+				MapMode:                 0x3A,
+				FastROM:                 true,
+				Chipset:                 0xF9,
+				ChipsetName:             "ROM+SPC7110+RAM+battery+RTC",
+				ROMSize:                 0x80_0000,
+				SRAMSize:                0x2000,
+				RegionName:              "Japan",
+				DeveloperID:             0x33,
+				Checksum:                0x1234,
+				ChecksumComplement:      0xEDCB,
+				ChecksumComplementValid: true,
+			},
+		},
+		{
+			// a version 1 header has no maker code or expansion RAM and its title fills all 21 bytes:
+			name:   "version 1 with bad checksum",
+			header: withBytes(header(0x21, 0x00, 0x09, 0x00), 0x20, ' ', 0x21, ' ', 0x22, ' ', 0x23, ' ', 0x24, 'X', 0x2A, 0x01, 0x0D, 0x05, 0x2C, 0x00),
+			want: RomInfo{
+				Title:                   "SATELLAVIEW GAME    X",
+				MapMode:                 0x21,
+				Chipset:                 0x00,
+				ChipsetName:             "ROM",
+				ROMSize:                 0x8_0000,
+				RegionName:              "Japan",
+				DeveloperID:             0x01,
+				Checksum:                0x1234,
+				ChecksumComplement:      0xED00,
+				ChecksumComplementValid: false,
+			},
+		},
+		{
+			name:    "too short",
+			header:  make([]byte, 0x2F),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRomInfo(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRomInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRomInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Package sdd1 translates addresses for S-DD1 carts such as Star Ocean and Street Fighter Alpha 2.
+//
+// The first 2MiB of ROM is always mapped LoROM style in banks $00-$3F while banks $C0-$FF map four 1MiB pages of ROM
+// HiROM style. The S-DD1 can switch those pages at runtime; only the pages selected at power on are modeled here, which
+// map the first 4MiB of ROM linearly:
+//
+//	$00-$3F,$80-$BF:8000-FFFF = ROM $00_0000-$1F_FFFF (LoROM)
+//	$C0-$FF:0000-FFFF         = ROM $00_0000-$3F_FFFF (HiROM)
+//	$70-$7D:0000-7FFF         = SRAM
+package sdd1
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank == 0x7E || bank == 0x7F {
+		// WRAM access:        $7E:0000-$7F:FFFF
+		return (busAddr - 0x7E0000) + 0xF50000, nil
+	} else if bank >= 0xC0 {
+		// ROM access (HiROM): $C0:0000-$FF:FFFF
+		return (busAddr - 0xC00000) + 0x000000, nil
+	} else if bank >= 0x70 && bank < 0x7E {
+		if offs&0x8000 == 0 {
+			// SRAM access:        $70:0000-$7D:7FFF
+			return util.BankToLinear(busAddr-0x700000) + 0xE00000, nil
+		}
+	} else if bank&0x7F < 0x40 {
+		if offs&0x8000 != 0 {
+			// ROM access (LoROM): $00:8000-$3F:FFFF
+			return util.BankToLinear(busAddr&0x3F7FFF) + 0x000000, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			return offs + 0xF50000, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		return ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000, nil
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE70000 {
+		// SRAM:               $70:0000-$7D:7FFF
+		offs := pakAddr - 0xE00000
+		bank := offs >> 15
+		return ((0x70 + bank) << 16) + (offs & 0x7FFF), nil
+	} else if pakAddr < 0x400000 {
+		// ROM:                $C0:0000-$FF:FFFF
+		return pakAddr + 0xC00000, nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
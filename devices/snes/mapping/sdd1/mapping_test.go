@@ -0,0 +1,71 @@
+package sdd1
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"header", 0x00FFC0, 0x007FC0, false},
+		{"rom lorom bank $3F", 0x3FFFFF, 0x1FFFFF, false},
+		{"rom hirom", 0xC00000, 0x000000, false},
+		{"rom hirom 4MiB", 0xFFFFFF, 0x3FFFFF, false},
+		{"sram", 0x700010, 0xE00010, false},
+		{"sram bank $7D", 0x7D7FFF, 0xE6FFFF, false},
+		{"wram", 0x7E0010, 0xF50010, false},
+		{"wram low mirror", 0x801FFF, 0xF51FFF, false},
+		{"sdd1 registers", 0x004800, 0x000000, true},
+		{"no rom in $40", 0x408000, 0x000000, true},
+		{"no rom above sram", 0x708000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak($%06x) error = %v, wantErr %v", tt.busAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, want $%06x", tt.busAddr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"rom start", 0x000000, 0xC00000, false},
+		{"header", 0x007FC0, 0xC07FC0, false},
+		{"rom 4MiB", 0x3FFFFF, 0xFFFFFF, false},
+		{"sram", 0xE00010, 0x700010, false},
+		{"sram bank $7D", 0xE6FFFF, 0x7D7FFF, false},
+		{"wram", 0xF50010, 0x7E0010, false},
+		{"past rom", 0x400000, 0x000000, true},
+		{"past sram", 0xE70000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus($%06x) error = %v, wantErr %v", tt.pakAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus($%06x) = $%06x, want $%06x", tt.pakAddr, got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			// every mapped pak address must map back to itself:
+			if back, err := BusAddressToPak(got); err != nil || back != tt.pakAddr {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, %v; want $%06x", got, back, err, tt.pakAddr)
+			}
+		})
+	}
+}
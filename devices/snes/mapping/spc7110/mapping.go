@@ -0,0 +1,56 @@
+// Package spc7110 translates addresses for SPC7110 carts such as Tengai Makyou Zero and Momotarou Dentetsu Happy.
+//
+// The first 1MiB of ROM is program ROM, mapped HiROM style in banks $C0-$CF and mirrored in banks $00-$0F. The rest is
+// data ROM, which banks $D0-$FF map in three 1MiB pages that the SPC7110 can switch at runtime; only the pages selected
+// at power on are modeled here, which map the next 3MiB of ROM linearly:
+//
+//	$C0-$CF:0000-FFFF         = program ROM $00_0000-$0F_FFFF
+//	$00-$0F,$80-$8F:8000-FFFF = program ROM mirror
+//	$D0-$FF:0000-FFFF         = data ROM $10_0000-$3F_FFFF
+//	$00-$3F,$80-$BF:6000-7FFF = SRAM
+package spc7110
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank == 0x7E || bank == 0x7F {
+		// WRAM access:        $7E:0000-$7F:FFFF
+		return (busAddr - 0x7E0000) + 0xF50000, nil
+	} else if bank >= 0xC0 {
+		// ROM access:         $C0:0000-$FF:FFFF
+		return (busAddr - 0xC00000) + 0x000000, nil
+	} else if bank&0x7F < 0x40 {
+		if offs&0x8000 != 0 {
+			if bank&0x7F < 0x10 {
+				// ROM mirror access:  $00:8000-$0F:FFFF
+				return ((bank&0x0F)<<16 | offs) + 0x000000, nil
+			}
+		} else if offs >= 0x6000 {
+			// SRAM access:        $00:6000-$3F:7FFF
+			return (offs - 0x6000) + 0xE00000, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			return offs + 0xF50000, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		return ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000, nil
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE02000 {
+		// SRAM:               $00:6000-$00:7FFF
+		return (pakAddr - 0xE00000) + 0x006000, nil
+	} else if pakAddr < 0x400000 {
+		// ROM:                $C0:0000-$FF:FFFF
+		return pakAddr + 0xC00000, nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,72 @@
+package spc7110
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"header", 0x00FFC0, 0x00FFC0, false},
+		{"program rom mirror bank $0F", 0x0F8000, 0x0F8000, false},
+		{"program rom", 0xC01234, 0x001234, false},
+		{"data rom", 0xD00000, 0x100000, false},
+		{"data rom end", 0xFFFFFF, 0x3FFFFF, false},
+		{"sram", 0x006000, 0xE00000, false},
+		{"sram fastrom mirror", 0xB07FFF, 0xE01FFF, false},
+		{"wram", 0x7E0010, 0xF50010, false},
+		{"wram low mirror", 0x001FFF, 0xF51FFF, false},
+		{"spc7110 registers", 0x004800, 0x000000, true},
+		{"no mirror in $10", 0x108000, 0x000000, true},
+		{"decompression buffer", 0x500000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak($%06x) error = %v, wantErr %v", tt.busAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, want $%06x", tt.busAddr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"program rom", 0x000000, 0xC00000, false},
+		{"header", 0x00FFC0, 0xC0FFC0, false},
+		{"data rom", 0x100000, 0xD00000, false},
+		{"data rom end", 0x3FFFFF, 0xFFFFFF, false},
+		{"sram", 0xE01FFF, 0x007FFF, false},
+		{"wram", 0xF50010, 0x7E0010, false},
+		{"past rom", 0x400000, 0x000000, true},
+		{"past sram", 0xE02000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus($%06x) error = %v, wantErr %v", tt.pakAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus($%06x) = $%06x, want $%06x", tt.pakAddr, got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			// every mapped pak address must map back to itself:
+			if back, err := BusAddressToPak(got); err != nil || back != tt.pakAddr {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, %v; want $%06x", got, back, err, tt.pakAddr)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package superfx translates addresses for SuperFX (GSU) carts such as Star Fox and Yoshi's Island.
+//
+// The same ROM of up to 2MiB is mapped twice, LoROM style in banks $00-$3F and HiROM style in banks $40-$5F, and the
+// game pak RAM shared with the GSU takes the place of SRAM:
+//
+//	$00-$3F,$80-$BF:8000-FFFF = ROM (LoROM)
+//	$40-$5F,$C0-$DF:0000-FFFF = ROM (HiROM)
+//	$70-$71,$F0-$F1:0000-FFFF = game pak RAM
+//	$00-$3F,$80-$BF:6000-7FFF = first 8KiB of game pak RAM
+package superfx
+
+import (
+	"github.com/alttpo/snes/mapping/util"
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	bank := busAddr >> 16
+	offs := busAddr & 0xFFFF
+
+	if bank == 0x7E || bank == 0x7F {
+		// WRAM access:        $7E:0000-$7F:FFFF
+		return (busAddr - 0x7E0000) + 0xF50000, nil
+	} else if bank&0x7F >= 0x70 && bank&0x7F <= 0x71 {
+		// RAM access:         $70:0000-$71:FFFF
+		return ((bank&0x01)<<16 | offs) + 0xE00000, nil
+	} else if bank&0x7F >= 0x40 && bank&0x7F <= 0x5F {
+		// ROM access (HiROM): $40:0000-$5F:FFFF
+		return ((bank&0x1F)<<16 | offs) + 0x000000, nil
+	} else if bank&0x7F < 0x40 {
+		if offs&0x8000 != 0 {
+			// ROM access (LoROM): $00:8000-$3F:FFFF
+			return util.BankToLinear(busAddr&0x3F7FFF) + 0x000000, nil
+		} else if offs >= 0x6000 {
+			// RAM mirror access:  $00:6000-$3F:7FFF
+			return (offs - 0x6000) + 0xE00000, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			return offs + 0xF50000, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 && pakAddr < 0x1_000000 {
+		// mirror bank $F7..FF back down into WRAM like the other mappings do:
+		return ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000, nil
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE20000 {
+		// RAM:                $70:0000-$71:FFFF
+		return (pakAddr - 0xE00000) + 0x700000, nil
+	} else if pakAddr < 0x200000 {
+		// ROM:                $80:8000-$BF:FFFF
+		offs := pakAddr & 0x7FFF
+		bank := pakAddr >> 15
+		return ((0x80 + bank) << 16) + (offs | 0x8000), nil
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,74 @@
+package superfx
+
+import "testing"
+
+func TestBusAddressToPak(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"header", 0x00FFC0, 0x007FC0, false},
+		{"rom lorom bank $3F", 0x3FFFFF, 0x1FFFFF, false},
+		{"rom hirom", 0x400000, 0x000000, false},
+		{"rom hirom bank $5F", 0x5FFFFF, 0x1FFFFF, false},
+		{"rom hirom fastrom", 0xC1FFC0, 0x01FFC0, false},
+		{"ram", 0x700010, 0xE00010, false},
+		{"ram bank $71", 0x71FFFF, 0xE1FFFF, false},
+		{"ram fastrom mirror", 0xF00010, 0xE00010, false},
+		{"ram window", 0x006010, 0xE00010, false},
+		{"wram", 0x7E0010, 0xF50010, false},
+		{"wram low mirror", 0x001FFF, 0xF51FFF, false},
+		{"gsu registers", 0x003000, 0x000000, true},
+		{"past rom", 0x600000, 0x000000, true},
+		{"past ram", 0x720000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BusAddressToPak(tt.busAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BusAddressToPak($%06x) error = %v, wantErr %v", tt.busAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, want $%06x", tt.busAddr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus(t *testing.T) {
+	tests := []struct {
+		name    string
+		pakAddr uint32
+		want    uint32
+		wantErr bool
+	}{
+		{"rom start", 0x000000, 0x808000, false},
+		{"header", 0x007FC0, 0x80FFC0, false},
+		{"rom end", 0x1FFFFF, 0xBFFFFF, false},
+		{"ram", 0xE01234, 0x701234, false},
+		{"ram end", 0xE1FFFF, 0x71FFFF, false},
+		{"wram", 0xF50010, 0x7E0010, false},
+		{"past rom", 0x200000, 0x000000, true},
+		{"past ram", 0xE20000, 0x000000, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PakAddressToBus(tt.pakAddr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PakAddressToBus($%06x) error = %v, wantErr %v", tt.pakAddr, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PakAddressToBus($%06x) = $%06x, want $%06x", tt.pakAddr, got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			// every mapped pak address must map back to itself:
+			if back, err := BusAddressToPak(got); err != nil || back != tt.pakAddr {
+				t.Errorf("BusAddressToPak($%06x) = $%06x, %v; want $%06x", got, back, err, tt.pakAddr)
+			}
+		})
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/alttpo/snes/mapping/lorom"
 	"github.com/alttpo/snes/mapping/sa1rom"
 	"sni/devices"
+	"sni/devices/snes/mapping/bsx"
+	"sni/devices/snes/mapping/exlorom"
+	"sni/devices/snes/mapping/sdd1"
+	"sni/devices/snes/mapping/spc7110"
+	"sni/devices/snes/mapping/superfx"
 	"sni/protos/sni"
 )
 
@@ -36,6 +41,16 @@ func TranslateAddress(
 				return exhirom.PakAddressToBus(address)
 			case sni.MemoryMapping_SA1:
 				return sa1rom.PakAddressToBus(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.PakAddressToBus(address)
+			case sni.MemoryMapping_ExLoROM:
+				return exlorom.PakAddressToBus(address)
+			case sni.MemoryMapping_SuperFX:
+				return superfx.PakAddressToBus(address)
+			case sni.MemoryMapping_SDD1:
+				return sdd1.PakAddressToBus(address)
+			case sni.MemoryMapping_SPC7110:
+				return spc7110.PakAddressToBus(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
@@ -56,6 +71,16 @@ func TranslateAddress(
 				return exhirom.BusAddressToPak(address)
 			case sni.MemoryMapping_SA1:
 				return sa1rom.BusAddressToPak(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.BusAddressToPak(address)
+			case sni.MemoryMapping_ExLoROM:
+				return exlorom.BusAddressToPak(address)
+			case sni.MemoryMapping_SuperFX:
+				return superfx.BusAddressToPak(address)
+			case sni.MemoryMapping_SDD1:
+				return sdd1.BusAddressToPak(address)
+			case sni.MemoryMapping_SPC7110:
+				return spc7110.BusAddressToPak(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
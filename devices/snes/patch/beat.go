@@ -0,0 +1,137 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"hash/crc32"
+	"sni/devices"
+)
+
+// footerSize is the size of the CRC32s of the source, target and patch that end BPS and UPS patches:
+const footerSize = 12
+
+// maxTargetSize bounds the sizes declared by BPS and UPS patches so a malformed patch cannot allocate too much:
+const maxTargetSize = 0x1_000000 * 4
+
+// reader decodes the variable length integers shared by the BPS and UPS formats, which were both designed by byuu.
+type reader struct {
+	f   Format
+	p   []byte
+	pos int
+	// end is where the footer starts:
+	end int
+}
+
+func (r *reader) byte() (b byte, err error) {
+	if r.pos >= r.end {
+		err = malformed(r.f, "unexpected end of patch at $%X", r.pos)
+		return
+	}
+	b = r.p[r.pos]
+	r.pos++
+	return
+}
+
+// number decodes a variable length integer: 7 bits per byte, least significant first, with the high bit set on the
+// last byte. Each continuation adds one to the next group so that every value has a single encoding.
+func (r *reader) number() (n uint64, err error) {
+	shift := uint64(1)
+	for {
+		var b byte
+		if b, err = r.byte(); err != nil {
+			return
+		}
+		n += uint64(b&0x7F) * shift
+		if b&0x80 != 0 {
+			return
+		}
+		shift <<= 7
+		if shift > 1<<56 {
+			err = malformed(r.f, "number at $%X is too large", r.pos)
+			return
+		}
+		n += shift
+	}
+}
+
+// size decodes a number that is the size of a ROM.
+func (r *reader) size(what string) (n int, err error) {
+	var v uint64
+	if v, err = r.number(); err != nil {
+		return
+	}
+	if v > maxTargetSize {
+		err = malformed(r.f, "%s size %d is too large", what, v)
+		return
+	}
+	n = int(v)
+	return
+}
+
+// footer holds the checksums that end a BPS or UPS patch.
+type footer struct {
+	source uint32
+	target uint32
+}
+
+// newReader checks the magic and the patch's own checksum and returns a reader positioned after the magic.
+func newReader(f Format, magic []byte, patch []byte) (r *reader, ft footer, err error) {
+	if len(patch) < len(magic)+footerSize || !bytes.HasPrefix(patch, magic) {
+		err = malformed(f, "missing '%s' header", magic)
+		return
+	}
+
+	end := len(patch) - footerSize
+	ft.source = binary.LittleEndian.Uint32(patch[end:])
+	ft.target = binary.LittleEndian.Uint32(patch[end+4:])
+	if actual, expected := crc32.ChecksumIEEE(patch[:end+8]), binary.LittleEndian.Uint32(patch[end+8:]); actual != expected {
+		err = malformed(f, "patch checksum %08x does not match %08x; the patch file is corrupt", actual, expected)
+		return
+	}
+
+	r = &reader{f: f, p: patch, pos: len(magic), end: end}
+	return
+}
+
+// checkSource validates the source against the size and checksum declared by the patch.
+func checkSource(f Format, source []byte, size int, checksum uint32) error {
+	if len(source) != size {
+		return devices.WithCode(codes.FailedPrecondition, fmt.Errorf("patch: %s patch expects a source of %d bytes but it is %d bytes", f, size, len(source)))
+	}
+	if actual := crc32.ChecksumIEEE(source); actual != checksum {
+		return devices.WithCode(codes.FailedPrecondition, fmt.Errorf("patch: %s patch expects a source with CRC32 %08x but it is %08x", f, checksum, actual))
+	}
+	return nil
+}
+
+// checkTarget validates the patched target against the checksum declared by the patch.
+func checkTarget(f Format, target []byte, checksum uint32) error {
+	if actual := crc32.ChecksumIEEE(target); actual != checksum {
+		return devices.WithCode(codes.DataLoss, fmt.Errorf("patch: %s patched target has CRC32 %08x but the patch expects %08x", f, actual, checksum))
+	}
+	return nil
+}
+
+// SourceSize returns the size of the source a BPS or UPS patch expects. IPS patches do not declare one.
+func SourceSize(patch []byte, format Format) (size int, err error) {
+	var f Format
+	if f, err = resolve(patch, format); err != nil {
+		return
+	}
+
+	var r *reader
+	switch f {
+	case BPS:
+		r, _, err = newReader(f, bpsMagic, patch)
+	case UPS:
+		r, _, err = newReader(f, upsMagic, patch)
+	default:
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: %s patches do not declare a source size", f))
+	}
+	if err != nil {
+		return
+	}
+	return r.size("source")
+}
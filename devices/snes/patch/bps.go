@@ -0,0 +1,120 @@
+package patch
+
+// BPS actions, stored in the low 2 bits of each action's number:
+const (
+	bpsSourceRead = iota
+	bpsTargetRead
+	bpsSourceCopy
+	bpsTargetCopy
+)
+
+// ApplyBPS applies a BPS patch to the source after checking that the source has the size and CRC32 the patch was
+// made for.
+func ApplyBPS(source, patch []byte) (target []byte, err error) {
+	var r *reader
+	var ft footer
+	if r, ft, err = newReader(BPS, bpsMagic, patch); err != nil {
+		return
+	}
+
+	var sourceSize, targetSize, metadataSize int
+	if sourceSize, err = r.size("source"); err != nil {
+		return
+	}
+	if targetSize, err = r.size("target"); err != nil {
+		return
+	}
+	if metadataSize, err = r.size("metadata"); err != nil {
+		return
+	}
+	if metadataSize > r.end-r.pos {
+		err = malformed(BPS, "metadata is truncated")
+		return
+	}
+	r.pos += metadataSize
+
+	if err = checkSource(BPS, source, sourceSize, ft.source); err != nil {
+		return
+	}
+
+	target = make([]byte, targetSize)
+	out := 0
+	sourceRelative, targetRelative := 0, 0
+
+	// relative decodes the signed offset of a copy action:
+	relative := func() (offset int, err error) {
+		var n uint64
+		if n, err = r.number(); err != nil {
+			return
+		}
+		offset = int(n >> 1)
+		if n&1 != 0 {
+			offset = -offset
+		}
+		return
+	}
+
+	for r.pos < r.end {
+		var n uint64
+		if n, err = r.number(); err != nil {
+			return
+		}
+		action, length := int(n&3), int(n>>2)+1
+		if length > targetSize-out {
+			err = malformed(BPS, "action at $%X writes beyond the target size", r.pos)
+			return
+		}
+
+		switch action {
+		case bpsSourceRead:
+			if out+length > len(source) {
+				err = malformed(BPS, "source read at $%X reads beyond the source", r.pos)
+				return
+			}
+			copy(target[out:], source[out:out+length])
+		case bpsTargetRead:
+			if length > r.end-r.pos {
+				err = malformed(BPS, "target read at $%X is truncated", r.pos)
+				return
+			}
+			copy(target[out:], patch[r.pos:r.pos+length])
+			r.pos += length
+		case bpsSourceCopy:
+			var offset int
+			if offset, err = relative(); err != nil {
+				return
+			}
+			sourceRelative += offset
+			if sourceRelative < 0 || sourceRelative+length > len(source) {
+				err = malformed(BPS, "source copy at $%X reads beyond the source", r.pos)
+				return
+			}
+			copy(target[out:], source[sourceRelative:sourceRelative+length])
+			sourceRelative += length
+		case bpsTargetCopy:
+			var offset int
+			if offset, err = relative(); err != nil {
+				return
+			}
+			targetRelative += offset
+			if targetRelative < 0 || targetRelative >= out {
+				err = malformed(BPS, "target copy at $%X reads beyond the target written so far", r.pos)
+				return
+			}
+			// copied byte by byte since the runs may overlap to repeat a pattern:
+			for i := 0; i < length; i++ {
+				target[out+i] = target[targetRelative]
+				targetRelative++
+			}
+		}
+		out += length
+	}
+
+	if out != targetSize {
+		err = malformed(BPS, "actions wrote %d bytes of a %d byte target", out, targetSize)
+		return
+	}
+
+	err = checkTarget(BPS, target, ft.target)
+	return
+}
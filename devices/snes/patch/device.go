@@ -0,0 +1,137 @@
+package patch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
+	"sni/protos/sni"
+)
+
+// Result describes a patch that was applied.
+type Result struct {
+	Format Format
+	// Writes is the number of contiguous runs of bytes written:
+	Writes int
+	// Size is the size of the patched ROM in bytes:
+	Size int
+}
+
+// ToMemory applies the patch live to the ROM space of the device, writing only the bytes that change, in the FX Pak
+// Pro address space. BPS and UPS patches read the source ROM of the size they declare from the device first. If
+// memoryMapping is Unknown and the device requires it, it is detected from the ROM header.
+func ToMemory(ctx context.Context, memory devices.DeviceMemory, patch []byte, format Format, memoryMapping sni.MemoryMapping) (r Result, err error) {
+	if r.Format, err = resolve(patch, format); err != nil {
+		return
+	}
+
+	if memoryMapping == sni.MemoryMapping_Unknown {
+		var requiresMemoryMapping bool
+		requiresMemoryMapping, err = memory.RequiresMemoryMappingForAddressSpace(ctx, sni.AddressSpace_FxPakPro)
+		if err != nil {
+			return
+		}
+		if requiresMemoryMapping {
+			if memoryMapping, _, _, err = mapping.Detect(ctx, memory, nil, nil); err != nil {
+				return
+			}
+		}
+	}
+
+	var records []Record
+	if r.Format == IPS {
+		var truncate int
+		if records, truncate, err = ParseIPS(patch); err != nil {
+			return
+		}
+		for _, record := range records {
+			r.Size = max(r.Size, int(record.Offset)+len(record.Data))
+		}
+		if truncate >= 0 {
+			// ROM in memory cannot shrink; only the records are written:
+			r.Size = min(r.Size, truncate)
+		}
+	} else {
+		var size int
+		if size, err = SourceSize(patch, r.Format); err != nil {
+			return
+		}
+		if size > romhash.MaxSize {
+			err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: source size $%X exceeds the ROM space", size))
+			return
+		}
+
+		source := make([]byte, 0, size)
+		err = romhash.Stream(ctx, memory, memoryMapping, 0, uint32(size), func(data []byte) {
+			source = append(source, data...)
+		})
+		if err != nil {
+			return
+		}
+
+		var target []byte
+		if target, _, err = Apply(source, patch, r.Format); err != nil {
+			return
+		}
+		r.Size = len(target)
+		records = Diff(source, target)
+	}
+
+	reqs := make([]devices.MemoryWriteRequest, 0, len(records))
+	for _, record := range records {
+		if len(record.Data) == 0 {
+			continue
+		}
+		if uint64(record.Offset)+uint64(len(record.Data)) > romhash.MaxSize {
+			err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: write of $%X bytes at $%06X exceeds the ROM space", len(record.Data), record.Offset))
+			return
+		}
+		reqs = append(reqs, devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       record.Offset,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Data: record.Data,
+		})
+	}
+
+	if len(reqs) > 0 {
+		if _, err = memory.MultiWriteMemory(ctx, reqs...); err != nil {
+			return
+		}
+	}
+	r.Writes = len(reqs)
+	return
+}
+
+// ToFile applies the patch to the file at path on the device's filesystem and writes the patched file to outputPath,
+// or back to path if outputPath is empty.
+func ToFile(ctx context.Context, fs devices.DeviceFilesystem, patch []byte, format Format, path, outputPath string) (r Result, err error) {
+	if r.Format, err = resolve(patch, format); err != nil {
+		return
+	}
+
+	source := bytes.Buffer{}
+	if _, err = fs.GetFile(ctx, path, &source, nil, nil); err != nil {
+		return
+	}
+
+	var target []byte
+	if target, _, err = Apply(source.Bytes(), patch, r.Format); err != nil {
+		return
+	}
+
+	if outputPath == "" {
+		outputPath = path
+	}
+	if _, err = fs.PutFile(ctx, outputPath, uint32(len(target)), bytes.NewReader(target), nil); err != nil {
+		return
+	}
+
+	r.Writes, r.Size = 1, len(target)
+	return
+}
@@ -0,0 +1,92 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// ipsEOF marks the end of the records; a record cannot start at offset $454F46 as a result:
+const ipsEOF = 0x454F46
+
+// ParseIPS parses the records of an IPS patch. RLE records are expanded. If the patch ends with the truncation
+// extension, truncate is the size to cut the target down to; otherwise it is -1.
+func ParseIPS(patch []byte) (records []Record, truncate int, err error) {
+	truncate = -1
+	if !bytes.HasPrefix(patch, ipsMagic) {
+		err = malformed(IPS, "missing 'PATCH' header")
+		return
+	}
+
+	p := patch[len(ipsMagic):]
+	for {
+		if len(p) < 3 {
+			err = malformed(IPS, "missing 'EOF' marker")
+			return
+		}
+		offset := uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+		p = p[3:]
+		if offset == ipsEOF {
+			break
+		}
+
+		if len(p) < 2 {
+			err = malformed(IPS, "record at $%06X is truncated", offset)
+			return
+		}
+		size := int(binary.BigEndian.Uint16(p))
+		p = p[2:]
+
+		if size == 0 {
+			// RLE record: 2 byte run length followed by the byte to repeat:
+			if len(p) < 3 {
+				err = malformed(IPS, "RLE record at $%06X is truncated", offset)
+				return
+			}
+			size = int(binary.BigEndian.Uint16(p))
+			data := make([]byte, size)
+			for i := range data {
+				data[i] = p[2]
+			}
+			p = p[3:]
+			records = append(records, Record{Offset: offset, Data: data})
+			continue
+		}
+
+		if len(p) < size {
+			err = malformed(IPS, "record at $%06X is truncated", offset)
+			return
+		}
+		records = append(records, Record{Offset: offset, Data: p[:size]})
+		p = p[size:]
+	}
+
+	if len(p) >= 3 {
+		truncate = int(p[0])<<16 | int(p[1])<<8 | int(p[2])
+	}
+	return
+}
+
+// ApplyIPS applies an IPS patch to the source. The target grows to fit records beyond the end of the source.
+func ApplyIPS(source, patch []byte) (target []byte, err error) {
+	var records []Record
+	var truncate int
+	if records, truncate, err = ParseIPS(patch); err != nil {
+		return
+	}
+
+	size := len(source)
+	for _, r := range records {
+		size = max(size, int(r.Offset)+len(r.Data))
+	}
+
+	target = make([]byte, size)
+	copy(target, source)
+	for _, r := range records {
+		copy(target[r.Offset:], r.Data)
+	}
+
+	if truncate >= 0 && truncate < len(target) {
+		target = target[:truncate]
+	}
+	return
+}
@@ -0,0 +1,120 @@
+// Package patch applies IPS, BPS and UPS ROM patches, either to the contents of a ROM file or live to the ROM space
+// of a device.
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+)
+
+// Format is the format of a patch file.
+type Format int
+
+const (
+	// DetectFormat detects the format from the magic bytes the patch starts with:
+	DetectFormat Format = iota
+	// IPS patches start with "PATCH" and list records of data to write at 24-bit offsets. They carry no checksums.
+	IPS
+	// BPS patches start with "BPS1" and copy runs of the source, the target or the patch itself to build the target.
+	// They carry CRC32s of the source, target and patch.
+	BPS
+	// UPS patches start with "UPS1" and list runs of bytes to XOR with the source. They carry CRC32s of the source,
+	// target and patch.
+	UPS
+)
+
+func (f Format) String() string {
+	switch f {
+	case DetectFormat:
+		return "detect"
+	case IPS:
+		return "ips"
+	case BPS:
+		return "bps"
+	case UPS:
+		return "ups"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+var (
+	ipsMagic = []byte("PATCH")
+	bpsMagic = []byte("BPS1")
+	upsMagic = []byte("UPS1")
+)
+
+// Detect returns the format of the patch from its magic bytes.
+func Detect(patch []byte) (f Format, err error) {
+	switch {
+	case bytes.HasPrefix(patch, ipsMagic):
+		f = IPS
+	case bytes.HasPrefix(patch, bpsMagic):
+		f = BPS
+	case bytes.HasPrefix(patch, upsMagic):
+		f = UPS
+	default:
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: unrecognized patch format"))
+	}
+	return
+}
+
+// resolve returns the format to use for the patch, detecting it if DetectFormat is given.
+func resolve(patch []byte, format Format) (Format, error) {
+	switch format {
+	case DetectFormat:
+		return Detect(patch)
+	case IPS, BPS, UPS:
+		return format, nil
+	default:
+		return format, devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: unknown format %v", format))
+	}
+}
+
+// Record is a run of bytes to write at an offset of the target.
+type Record struct {
+	Offset uint32
+	Data   []byte
+}
+
+// Apply patches the source, detecting the format if DetectFormat is given, and returns the patched target. The
+// source is not modified.
+func Apply(source, patch []byte, format Format) (target []byte, f Format, err error) {
+	if f, err = resolve(patch, format); err != nil {
+		return
+	}
+
+	switch f {
+	case IPS:
+		target, err = ApplyIPS(source, patch)
+	case BPS:
+		target, err = ApplyBPS(source, patch)
+	case UPS:
+		target, err = ApplyUPS(source, patch)
+	}
+	return
+}
+
+// Diff returns the records that turn source into target. Bytes of target beyond the end of source are always
+// included since whatever follows the source in memory is unknown.
+func Diff(source, target []byte) (records []Record) {
+	for i := 0; i < len(target); {
+		if i < len(source) && source[i] == target[i] {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(target) && (i >= len(source) || source[i] != target[i]) {
+			i++
+		}
+		records = append(records, Record{Offset: uint32(start), Data: target[start:i]})
+	}
+	return
+}
+
+func malformed(f Format, format string, args ...interface{}) error {
+	return devices.WithCode(codes.InvalidArgument, fmt.Errorf("patch: malformed %s patch: %s", f, fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,336 @@
+package patch
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"hash/crc32"
+	"io"
+	"sni/devices"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"testing"
+)
+
+func source() []byte {
+	s := make([]byte, 0x100)
+	for i := range s {
+		s[i] = byte(i)
+	}
+	return s
+}
+
+func ipsPatch() []byte {
+	p := []byte("PATCH")
+	// 3 bytes at $10:
+	p = append(p, 0x00, 0x00, 0x10, 0x00, 0x03, 0xAA, 0xBB, 0xCC)
+	// RLE of 4 bytes of $EE at $20:
+	p = append(p, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x04, 0xEE)
+	// 2 bytes beyond the end of the source at $101:
+	p = append(p, 0x00, 0x01, 0x01, 0x00, 0x02, 0x11, 0x22)
+	return append(p, "EOF"...)
+}
+
+// encodeNumber encodes a BPS/UPS variable length integer.
+func encodeNumber(n uint64) (b []byte) {
+	for {
+		x := byte(n & 0x7F)
+		n >>= 7
+		if n == 0 {
+			return append(b, x|0x80)
+		}
+		b = append(b, x)
+		n--
+	}
+}
+
+// withFooter appends the source, target and patch CRC32s.
+func withFooter(p []byte, source, target []byte) []byte {
+	p = binary.LittleEndian.AppendUint32(p, crc32.ChecksumIEEE(source))
+	p = binary.LittleEndian.AppendUint32(p, crc32.ChecksumIEEE(target))
+	return binary.LittleEndian.AppendUint32(p, crc32.ChecksumIEEE(p))
+}
+
+// expected is the target all the test patches produce from source:
+func expected() []byte {
+	t := append(source(), 0x00, 0x00, 0x00, 0x00)
+	copy(t[0x10:], []byte{0xAA, 0xBB, 0xCC})
+	copy(t[0x20:], []byte{0xEE, 0xEE, 0xEE, 0xEE})
+	copy(t[0x101:], []byte{0x11, 0x22})
+	return t[:0x103]
+}
+
+func bpsPatch(source []byte) []byte {
+	target := expected()
+	p := []byte("BPS1")
+	p = append(p, encodeNumber(uint64(len(source)))...)
+	p = append(p, encodeNumber(uint64(len(target)))...)
+	p = append(p, encodeNumber(3)...)
+	p = append(p, "abc"...)
+
+	action := func(kind int, length int) {
+		p = append(p, encodeNumber(uint64((length-1)<<2|kind))...)
+	}
+	// $00-$0F from the source:
+	action(bpsSourceRead, 0x10)
+	// $10-$12 from the patch:
+	action(bpsTargetRead, 3)
+	p = append(p, 0xAA, 0xBB, 0xCC)
+	// $13-$1F copied from the source at $13:
+	action(bpsSourceCopy, 0x0D)
+	p = append(p, encodeNumber(0x13<<1)...)
+	// $20 from the patch, then $21-$23 repeated from the target at $20:
+	action(bpsTargetRead, 1)
+	p = append(p, 0xEE)
+	action(bpsTargetCopy, 3)
+	p = append(p, encodeNumber(0x20<<1)...)
+	// $24-$FF from the source:
+	action(bpsSourceRead, 0xDC)
+	// $100 copied from the source at $00 by a negative offset from $20:
+	action(bpsSourceCopy, 1)
+	p = append(p, encodeNumber(0x20<<1|1)...)
+	action(bpsTargetRead, 2)
+	p = append(p, 0x11, 0x22)
+
+	return withFooter(p, source, target)
+}
+
+func upsPatch(source []byte) []byte {
+	target := expected()
+	p := []byte("UPS1")
+	p = append(p, encodeNumber(uint64(len(source)))...)
+	p = append(p, encodeNumber(uint64(len(target)))...)
+
+	// hunks of bytes to XOR, each ended by a zero for the unchanged byte that follows it:
+	xor := make([]byte, len(target))
+	for i := range target {
+		xor[i] = target[i]
+		if i < len(source) {
+			xor[i] ^= source[i]
+		}
+	}
+	last := 0
+	for i := 0; i < len(xor); {
+		if xor[i] == 0 {
+			i++
+			continue
+		}
+		p = append(p, encodeNumber(uint64(i-last))...)
+		for ; i < len(xor) && xor[i] != 0; i++ {
+			p = append(p, xor[i])
+		}
+		p = append(p, 0)
+		i++
+		last = i
+	}
+
+	return withFooter(p, source, target)
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		patch []byte
+		want  Format
+	}{
+		{ipsPatch(), IPS},
+		{bpsPatch(source()), BPS},
+		{upsPatch(source()), UPS},
+	}
+	for _, tt := range tests {
+		got, err := Detect(tt.patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Detect() = %v, want %v", got, tt.want)
+		}
+	}
+
+	if _, err := Detect([]byte("NOT A PATCH")); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Errorf("Detect() of garbage error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch []byte
+	}{
+		{"ips", ipsPatch()},
+		{"bps", bpsPatch(source())},
+		{"ups", upsPatch(source())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := source()
+			got, _, err := Apply(s, tt.patch, DetectFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, expected()) {
+				t.Errorf("Apply() = % x, want % x", got, expected())
+			}
+			if !bytes.Equal(s, source()) {
+				t.Errorf("Apply() modified the source")
+			}
+		})
+	}
+}
+
+func TestApplyIPSTruncate(t *testing.T) {
+	p := append(ipsPatch(), 0x00, 0x00, 0x80)
+	got, err := ApplyIPS(source(), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, expected()[:0x80]) {
+		t.Errorf("ApplyIPS() = % x, want % x", got, expected()[:0x80])
+	}
+}
+
+func TestApplyWrongSource(t *testing.T) {
+	wrong := source()
+	wrong[0x80] ^= 0xFF
+
+	for _, p := range [][]byte{bpsPatch(source()), upsPatch(source())} {
+		if _, _, err := Apply(wrong, p, DetectFormat); !snestest.IsCode(err, codes.FailedPrecondition) {
+			t.Errorf("Apply() to the wrong source error = %v, want FailedPrecondition", err)
+		}
+		if _, _, err := Apply(source()[:0x80], p, DetectFormat); !snestest.IsCode(err, codes.FailedPrecondition) {
+			t.Errorf("Apply() to a short source error = %v, want FailedPrecondition", err)
+		}
+	}
+}
+
+func TestApplyMalformed(t *testing.T) {
+	corrupt := bpsPatch(source())
+	corrupt[8] ^= 0xFF
+
+	tests := []struct {
+		name  string
+		patch []byte
+	}{
+		{"ips without EOF", ipsPatch()[:len(ipsPatch())-3]},
+		{"ips truncated record", []byte("PATCH\x00\x00\x10\x00\x05\xAA")},
+		{"bps corrupt", corrupt},
+		{"bps truncated", bpsPatch(source())[:20]},
+		{"ups truncated", upsPatch(source())[:8]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Apply(source(), tt.patch, DetectFormat); !snestest.IsCode(err, codes.InvalidArgument) {
+				t.Errorf("Apply() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := Diff(source(), expected())
+	want := []Record{
+		{Offset: 0x10, Data: []byte{0xAA, 0xBB, 0xCC}},
+		{Offset: 0x20, Data: []byte{0xEE, 0xEE, 0xEE, 0xEE}},
+		{Offset: 0x100, Data: []byte{0x00, 0x11, 0x22}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Diff() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Offset != want[i].Offset || !bytes.Equal(got[i].Data, want[i].Data) {
+			t.Errorf("Diff()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestToMemory(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch []byte
+	}{
+		{"ips", ipsPatch()},
+		{"bps", bpsPatch(source())},
+		{"ups", upsPatch(source())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &snestest.PakMemory{}
+			copy(m.Memory[:], source())
+
+			r, err := ToMemory(context.Background(), m, tt.patch, DetectFormat, sni.MemoryMapping_LoROM)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Writes != 3 || r.Size != len(expected()) {
+				t.Errorf("ToMemory() = %+v, want 3 writes of a $%X byte ROM", r, len(expected()))
+			}
+			if !bytes.Equal(m.Memory[:len(expected())], expected()) {
+				t.Errorf("ROM = % x, want % x", m.Memory[:len(expected())], expected())
+			}
+			for _, w := range m.Writes {
+				if w.RequestAddress.AddressSpace != sni.AddressSpace_FxPakPro {
+					t.Errorf("write to %v, want FxPakPro address space", w.RequestAddress)
+				}
+			}
+		})
+	}
+}
+
+func TestToMemoryOutsideRom(t *testing.T) {
+	m := &snestest.PakMemory{}
+	p := append([]byte("PATCH\xF5\x00\x00\x00\x01\xFF"), "EOF"...)
+	if _, err := ToMemory(context.Background(), m, p, IPS, sni.MemoryMapping_LoROM); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Errorf("ToMemory() error = %v, want InvalidArgument", err)
+	}
+	if len(m.Writes) != 0 {
+		t.Errorf("ToMemory() wrote %d runs, want none", len(m.Writes))
+	}
+}
+
+// fakeFilesystem holds files in memory:
+type fakeFilesystem struct {
+	devices.DeviceFilesystem
+	files map[string][]byte
+}
+
+func (f *fakeFilesystem) GetFile(_ context.Context, path string, w io.Writer, _ devices.SizeReceivedFunc, _ devices.ProgressReportFunc) (uint32, error) {
+	data, ok := f.files[path]
+	if !ok {
+		return 0, errors.New("file not found")
+	}
+	n, err := w.Write(data)
+	return uint32(n), err
+}
+
+func (f *fakeFilesystem) PutFile(_ context.Context, path string, size uint32, r io.Reader, _ devices.ProgressReportFunc) (uint32, error) {
+	data := make([]byte, size)
+	n, err := io.ReadFull(r, data)
+	f.files[path] = data
+	return uint32(n), err
+}
+
+func TestToFile(t *testing.T) {
+	fs := &fakeFilesystem{files: map[string][]byte{"/rom.sfc": source()}}
+
+	r, err := ToFile(context.Background(), fs, bpsPatch(source()), DetectFormat, "/rom.sfc", "/patched.sfc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Format != BPS || r.Size != len(expected()) {
+		t.Errorf("ToFile() = %+v, want a $%X byte BPS result", r, len(expected()))
+	}
+	if !bytes.Equal(fs.files["/patched.sfc"], expected()) {
+		t.Errorf("/patched.sfc = % x, want % x", fs.files["/patched.sfc"], expected())
+	}
+	if !bytes.Equal(fs.files["/rom.sfc"], source()) {
+		t.Errorf("/rom.sfc was modified")
+	}
+
+	if _, err = ToFile(context.Background(), fs, ipsPatch(), DetectFormat, "/rom.sfc", ""); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fs.files["/rom.sfc"], expected()) {
+		t.Errorf("/rom.sfc = % x, want % x", fs.files["/rom.sfc"], expected())
+	}
+}
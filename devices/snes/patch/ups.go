@@ -0,0 +1,60 @@
+package patch
+
+// ApplyUPS applies a UPS patch to the source after checking that the source has the size and CRC32 the patch was
+// made for. UPS patches can be applied in either direction, but only source to target is supported here.
+func ApplyUPS(source, patch []byte) (target []byte, err error) {
+	var r *reader
+	var ft footer
+	if r, ft, err = newReader(UPS, upsMagic, patch); err != nil {
+		return
+	}
+
+	var sourceSize, targetSize int
+	if sourceSize, err = r.size("source"); err != nil {
+		return
+	}
+	if targetSize, err = r.size("target"); err != nil {
+		return
+	}
+
+	if err = checkSource(UPS, source, sourceSize, ft.source); err != nil {
+		return
+	}
+
+	target = make([]byte, targetSize)
+	copy(target, source)
+
+	out := 0
+	for r.pos < r.end {
+		var skip uint64
+		if skip, err = r.number(); err != nil {
+			return
+		}
+		if skip > uint64(targetSize-out) {
+			err = malformed(UPS, "hunk at $%X starts beyond the target size", r.pos)
+			return
+		}
+		out += int(skip)
+
+		// XOR bytes until a zero byte, which stands for the unchanged byte ending the hunk:
+		for {
+			var b byte
+			if b, err = r.byte(); err != nil {
+				return
+			}
+			if b == 0 {
+				break
+			}
+			if out >= targetSize {
+				err = malformed(UPS, "hunk at $%X writes beyond the target size", r.pos)
+				return
+			}
+			target[out] ^= b
+			out++
+		}
+		out++
+	}
+
+	err = checkTarget(UPS, target, ft.target)
+	return
+}
@@ -0,0 +1,281 @@
+// Package romhash computes CRC32, SHA-1 and MD5 hashes of the ROM loaded on a device by reading its ROM space, for
+// devices that cannot report a hash of the ROM themselves.
+package romhash
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"hash/crc32"
+	"io"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/mapping/bsx"
+	"sni/protos/sni"
+	"strconv"
+	"sync"
+)
+
+const (
+	// chunkSize is how much ROM is read at once:
+	chunkSize = 0x1_0000
+	// MaxSize is the size of the ROM range of the FX Pak Pro address space:
+	MaxSize = 0xE0_0000
+)
+
+// Hashes of a ROM.
+type Hashes struct {
+	CRC32 uint32
+	SHA1  [sha1.Size]byte
+	MD5   [md5.Size]byte
+	// Size is the number of bytes of ROM that were hashed:
+	Size uint32
+	// ChecksumValid is true if the ROM sums to the checksum in its header:
+	ChecksumValid bool
+}
+
+func (h *Hashes) CRC32String() string { return fmt.Sprintf("%08x", h.CRC32) }
+func (h *Hashes) SHA1String() string  { return hex.EncodeToString(h.SHA1[:]) }
+func (h *Hashes) MD5String() string   { return hex.EncodeToString(h.MD5[:]) }
+
+// minRemainder is the smallest part of a ROM beyond a power of two that is looked for:
+const minRemainder = 0x8000
+
+// sizer finds the size of a ROM while it is streamed by summing it. A ROM whose size is not a power of two declares
+// the next power of two as its size in the header, and its checksum sums the remainder beyond the largest power of
+// two as if mirrored up to the declared size, e.g. a 3MiB ROM is declared as 4MiB and its checksum sums its last
+// 1MiB twice.
+type sizer struct {
+	declared uint32
+	half     uint32
+	checksum uint16
+
+	sum     uint32
+	halfSum uint32
+}
+
+// boundaries returns the sizes the ROM may have, smallest first. The declared size is always last.
+func (z *sizer) boundaries() (sizes []uint32) {
+	for r := uint32(minRemainder); r < z.half; r <<= 1 {
+		sizes = append(sizes, z.half+r)
+	}
+	return append(sizes, z.declared)
+}
+
+// write sums the ROM data read.
+func (z *sizer) write(data []byte) {
+	for _, b := range data {
+		z.sum += uint32(b)
+	}
+}
+
+// matches reports whether the ROM summed so far with the given size matches the checksum of the header.
+func (z *sizer) matches(size uint32) bool {
+	remainder := size - z.half
+	return uint16(z.halfSum+(z.sum-z.halfSum)*(z.half/remainder)) == z.checksum
+}
+
+// Compute hashes the ROM by streaming it from the device in chunks. A header declaring a power of two larger than
+// the ROM, as for a 3MiB ROM declared as 4MiB, is resolved by hashing only up to the smallest size whose mirrored sum
+// matches the header checksum. The declared size is hashed if none does, as for ROMs with a wrong checksum.
+func Compute(ctx context.Context, memory devices.DeviceMemory, memoryMapping sni.MemoryMapping, headerBytes []byte) (h Hashes, err error) {
+	var info mapping.RomInfo
+	if info, err = mapping.ParseRomInfo(headerBytes); err != nil {
+		return
+	}
+
+	size := info.ROMSize
+	if memoryMapping == sni.MemoryMapping_BSX {
+		// the BS-X header has no ROM size; hash the whole memory pack:
+		size = bsx.FlashSize
+	}
+	if size == 0 {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("romhash: ROM header does not declare a valid ROM size"))
+		return
+	}
+	size = min(size, MaxSize)
+
+	// the BS-X header has no checksum of the whole memory pack to find its size by:
+	var sizes []uint32
+	z := sizer{declared: size, half: size / 2, checksum: info.Checksum}
+	if memoryMapping == sni.MemoryMapping_BSX {
+		sizes = []uint32{size}
+	} else {
+		sizes = z.boundaries()
+	}
+
+	crc := crc32.NewIEEE()
+	sha := sha1.New()
+	md := md5.New()
+	w := io.MultiWriter(crc, sha, md)
+
+	read := func(data []byte) {
+		_, _ = w.Write(data)
+		z.write(data)
+	}
+
+	// the first half is summed on its own so the rest can be summed as mirrored:
+	if err = Stream(ctx, memory, memoryMapping, 0, z.half, read); err != nil {
+		return
+	}
+	z.halfSum = z.sum
+
+	// read up to each possible size in turn so the sum can be checked there:
+	address := z.half
+	for i, end := range sizes {
+		if err = Stream(ctx, memory, memoryMapping, address, end, read); err != nil {
+			return
+		}
+		address = end
+
+		h.ChecksumValid = memoryMapping != sni.MemoryMapping_BSX && z.matches(end)
+		if h.ChecksumValid || i == len(sizes)-1 {
+			size = end
+			break
+		}
+	}
+
+	h.CRC32 = crc.Sum32()
+	copy(h.SHA1[:], sha.Sum(nil))
+	copy(h.MD5[:], md.Sum(nil))
+	h.Size = size
+	return
+}
+
+// Stream reads the ROM from start up to end from the device in chunks, in the FX Pak Pro address space, and passes
+// each chunk to read in order.
+func Stream(ctx context.Context, memory devices.DeviceMemory, memoryMapping sni.MemoryMapping, start, end uint32, read func(data []byte)) (err error) {
+	for address := start; address < end; address += chunkSize {
+		var rsps []devices.MemoryReadResponse
+		rsps, err = memory.MultiReadMemory(ctx, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       address,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Size: int(min(chunkSize, end-address)),
+		})
+		if err != nil {
+			return
+		}
+		read(rsps[0].Data)
+	}
+	return
+}
+
+type cached struct {
+	mu            sync.Mutex
+	memoryMapping sni.MemoryMapping
+	header        []byte
+	hashes        *Hashes
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = make(map[string]*cached)
+)
+
+func cachedFor(uri string) *cached {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	c, ok := cache[uri]
+	if !ok {
+		c = &cached{}
+		cache[uri] = c
+	}
+	return c
+}
+
+// ForDevice returns the hashes of the ROM loaded on the device and its header read from $00:FFB0. They are only
+// computed again once the ROM header differs from the one seen when they were last computed.
+func ForDevice(ctx context.Context, memory devices.DeviceMemory, uri string) (h Hashes, header []byte, err error) {
+	c := cachedFor(uri)
+
+	// hold the lock while hashing so concurrent requests wait for one hash instead of each reading the ROM:
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.hashes != nil {
+		var rsps []devices.MemoryReadResponse
+		rsps, err = memory.MultiReadMemory(ctx, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       0x00FFB0,
+				AddressSpace:  sni.AddressSpace_SnesABus,
+				MemoryMapping: c.memoryMapping,
+			},
+			Size: len(c.header),
+		})
+		if err != nil {
+			return
+		}
+		if bytes.Equal(rsps[0].Data, c.header) {
+			return *c.hashes, c.header, nil
+		}
+	}
+
+	var memoryMapping sni.MemoryMapping
+	memoryMapping, _, header, err = mapping.Detect(ctx, memory, nil, nil)
+	if err != nil {
+		return
+	}
+
+	if h, err = Compute(ctx, memory, memoryMapping, header); err != nil {
+		return
+	}
+
+	c.memoryMapping, c.header, c.hashes = memoryMapping, header, &h
+	return
+}
+
+// FillFields sets the values of ROM hash fields that the driver left empty to hashes computed by ForDevice. The ROM
+// is only read if such a field is present.
+func FillFields(ctx context.Context, memory devices.DeviceMemory, uri string, fields []sni.Field, values []string) (err error) {
+	var h *Hashes
+	for i, field := range fields {
+		if values[i] != "" {
+			continue
+		}
+
+		switch field {
+		case sni.Field_RomHashType, sni.Field_RomHashValue, sni.Field_RomCRC32, sni.Field_RomSHA1, sni.Field_RomMD5:
+		default:
+			continue
+		}
+
+		if h == nil {
+			var hashes Hashes
+			if hashes, _, err = ForDevice(ctx, memory, uri); err != nil {
+				return
+			}
+			h = &hashes
+		}
+
+		switch field {
+		case sni.Field_RomHashType:
+			values[i] = "crc32"
+		case sni.Field_RomHashValue:
+			// formatted the same way as the RetroArch driver does:
+			values[i] = strconv.FormatUint(uint64(h.CRC32), 16)
+		case sni.Field_RomCRC32:
+			values[i] = h.CRC32String()
+		case sni.Field_RomSHA1:
+			values[i] = h.SHA1String()
+		case sni.Field_RomMD5:
+			values[i] = h.MD5String()
+		}
+	}
+	return
+}
+
+// Forget discards the hashes cached for the device, e.g. after its ROM was modified without changing the header.
+func Forget(uri string) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	delete(cache, uri)
+}
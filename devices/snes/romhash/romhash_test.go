@@ -0,0 +1,149 @@
+package romhash
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/binary"
+	"hash/crc32"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"strconv"
+	"testing"
+)
+
+// newPakMemory loads a 256KiB LoROM game:
+func newPakMemory() *snestest.PakMemory {
+	m := &snestest.PakMemory{}
+	for i := 0; i < 0x4_0000; i++ {
+		m.Memory[i] = byte(i * 7)
+	}
+	m.LoadROM("HASH TEST", 0x1234, 0x00)
+	m.Memory[0x7FB0+0x27] = 0x08 // 256KiB ROM
+	return m
+}
+
+func TestForDevice(t *testing.T) {
+	ctx := context.Background()
+	m := newPakMemory()
+
+	rom := m.Memory[:0x4_0000]
+	wantSHA1 := sha1.Sum(rom)
+	wantMD5 := md5.Sum(rom)
+
+	h, header, err := ForDevice(ctx, m, "test:hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Size != 0x4_0000 || h.CRC32 != crc32.ChecksumIEEE(rom) || h.SHA1 != wantSHA1 || h.MD5 != wantMD5 {
+		t.Fatalf("unexpected hashes of $%x bytes: %s %s %s", h.Size, h.CRC32String(), h.SHA1String(), h.MD5String())
+	}
+	if string(header[0x10:0x25]) != "HASH TEST            " {
+		t.Fatalf("unexpected header %q", header[0x10:0x25])
+	}
+
+	// the same ROM is only checked by reading its header:
+	m.Reads = 0
+	if _, _, err = ForDevice(ctx, m, "test:hash"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Reads != 1 {
+		t.Fatalf("expected cached hashes to take 1 read but took %d", m.Reads)
+	}
+
+	// a different ROM is hashed again:
+	m.Memory[0x7FB0+0x10] = 'C'
+	m.Memory[0] ^= 0xFF
+	h2, _, err := ForDevice(ctx, m, "test:hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h2.CRC32 != crc32.ChecksumIEEE(m.Memory[:0x4_0000]) || h2.CRC32 == h.CRC32 {
+		t.Fatalf("expected hashes of the changed ROM but got %s", h2.CRC32String())
+	}
+}
+
+func TestFillFields(t *testing.T) {
+	m := newPakMemory()
+	h, err := Compute(context.Background(), m, sni.MemoryMapping_LoROM, m.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := []sni.Field{sni.Field_DeviceName, sni.Field_RomHashType, sni.Field_RomHashValue, sni.Field_RomCRC32, sni.Field_RomSHA1, sni.Field_RomMD5}
+	values := []string{"fxpakpro", "", "", "", "", "provided"}
+	if err = FillFields(context.Background(), m, "test:fill", fields, values); err != nil {
+		t.Fatal(err)
+	}
+
+	// RomHashValue is not zero-padded, like RetroArch's:
+	want := []string{"fxpakpro", "crc32", strconv.FormatUint(uint64(h.CRC32), 16), h.CRC32String(), h.SHA1String(), "provided"}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("%s = %q, want %q", fields[i], values[i], want[i])
+		}
+	}
+
+	// no ROM is read without a hash field:
+	m.Reads = 0
+	if err = FillFields(context.Background(), m, "test:none", []sni.Field{sni.Field_DeviceName}, []string{""}); err != nil || m.Reads != 0 {
+		t.Fatalf("expected no reads but took %d: %v", m.Reads, err)
+	}
+}
+
+func TestComputeNonPowerOfTwo(t *testing.T) {
+	// a 3MiB ROM, like Super Metroid, is declared as 4MiB and its checksum sums its last 1MiB twice:
+	m := newPakMemory()
+	x := uint32(1)
+	for i := 0; i < 0x30_0000; i++ {
+		x ^= x << 13
+		x ^= x >> 17
+		x ^= x << 5
+		m.Memory[i] = byte(x)
+	}
+	// the device maps something else past the end of the ROM:
+	for i := 0x30_0000; i < 0x40_0000; i++ {
+		m.Memory[i] = 0xFF
+	}
+	h := m.Memory[0x7FB0 : 0x7FB0+0x50]
+	copy(h[0x10:0x25], "SIZE TEST            ")
+	h[0x25] = 0x20
+	h[0x27] = 0x0C // 4MiB ROM
+	binary.LittleEndian.PutUint16(h[0x2C:], 0xFFFF)
+	binary.LittleEndian.PutUint16(h[0x2E:], 0x0000)
+
+	var sum uint32
+	for _, b := range m.Memory[:0x20_0000] {
+		sum += uint32(b)
+	}
+	for _, b := range m.Memory[0x20_0000:0x30_0000] {
+		sum += uint32(b) * 2
+	}
+	binary.LittleEndian.PutUint16(h[0x2C:], ^uint16(sum))
+	binary.LittleEndian.PutUint16(h[0x2E:], uint16(sum))
+
+	hashes, err := Compute(context.Background(), m, sni.MemoryMapping_LoROM, m.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rom := m.Memory[:0x30_0000]
+	if hashes.Size != 0x30_0000 || hashes.SHA1 != sha1.Sum(rom) || hashes.CRC32 != crc32.ChecksumIEEE(rom) {
+		t.Fatalf("expected the 3MiB ROM to be hashed but hashed $%x bytes", hashes.Size)
+	}
+	if !hashes.ChecksumValid {
+		t.Fatal("expected the checksum to be valid")
+	}
+
+	// the declared size is hashed when the checksum matches no size:
+	binary.LittleEndian.PutUint16(h[0x2E:], uint16(sum)+1)
+	binary.LittleEndian.PutUint16(h[0x2C:], ^(uint16(sum) + 1))
+	if hashes, err = Compute(context.Background(), m, sni.MemoryMapping_LoROM, m.Header()); err != nil {
+		t.Fatal(err)
+	}
+	if hashes.Size != 0x40_0000 || hashes.SHA1 != sha1.Sum(m.Memory[:0x40_0000]) {
+		t.Fatalf("expected the declared 4MiB to be hashed but hashed $%x bytes", hashes.Size)
+	}
+	if hashes.ChecksumValid {
+		t.Fatal("expected the checksum to be invalid")
+	}
+}
@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+)
+
+// Value is a decoded field value: uint64 for unsigned and BCD types, int64 for signed types, map[string]bool for
+// bitfields, []byte for bytes, or []Value for arrays.
+type Value interface{}
+
+func littleEndian(data []byte) (v uint64) {
+	for i := len(data) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(data[i])
+	}
+	return
+}
+
+// bcd decodes little-endian binary-coded decimal; nibbles above 9 are decoded as their value.
+func bcd(data []byte) (v uint64) {
+	for i := len(data) - 1; i >= 0; i-- {
+		v = v*100 + uint64(data[i]>>4)*10 + uint64(data[i]&0xF)
+	}
+	return
+}
+
+func (f *Field) decodeValue(data []byte) Value {
+	switch f.Type {
+	case U8, U16LE, U24LE, U32LE:
+		return littleEndian(data)
+	case S8:
+		return int64(int8(data[0]))
+	case S16LE:
+		return int64(int16(littleEndian(data)))
+	case BCD8, BCD16:
+		return bcd(data)
+	case Bitfield:
+		bits := littleEndian(data)
+		m := make(map[string]bool, len(f.Bits))
+		for name, bit := range f.Bits {
+			m[name] = bits&(1<<bit) != 0
+		}
+		return m
+	default:
+		return append([]byte(nil), data...)
+	}
+}
+
+// Decode decodes the field from data, which must be at least as long as the span of the field.
+func (f *Field) Decode(data []byte) Value {
+	size := f.valueSize()
+	if f.Count == 0 {
+		return f.decodeValue(data[:size])
+	}
+
+	values := make([]Value, 0, f.Count)
+	stride := f.stride()
+	for i := uint32(0); i < f.Count; i++ {
+		values = append(values, f.decodeValue(data[i*stride:i*stride+size]))
+	}
+	return values
+}
+
+// Read reads the named fields of the schema, or all fields if none are named, from the device and decodes them.
+func (s *Schema) Read(ctx context.Context, memory devices.DeviceMemory, fieldNames []string) (values map[string]Value, err error) {
+	fields := s.Fields
+	if len(fieldNames) > 0 {
+		fields = make([]Field, 0, len(fieldNames))
+		for _, name := range fieldNames {
+			found := false
+			for _, f := range s.Fields {
+				if f.Name == name {
+					fields = append(fields, f)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, devices.WithCode(codes.NotFound, fmt.Errorf("schema: '%s' has no field '%s'", s.Name, name))
+			}
+		}
+	}
+
+	memoryMapping := s.memoryMapping
+	if memoryMapping == sni.MemoryMapping_Unknown && s.addressSpace == sni.AddressSpace_SnesABus {
+		if memoryMapping, _, _, err = mapping.Detect(ctx, memory, nil, nil); err != nil {
+			return
+		}
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(fields))
+	for i := range fields {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       s.Base + fields[i].Offset,
+				AddressSpace:  s.addressSpace,
+				MemoryMapping: memoryMapping,
+			},
+			Size: int(fields[i].span()),
+		})
+	}
+
+	var rsps []devices.MemoryReadResponse
+	if rsps, err = memory.MultiReadMemory(ctx, reads...); err != nil {
+		return
+	}
+	if len(rsps) != len(reads) {
+		return nil, fmt.Errorf("schema: expected %d responses but got %d", len(reads), len(rsps))
+	}
+
+	values = make(map[string]Value, len(fields))
+	for i := range fields {
+		if len(rsps[i].Data) < reads[i].Size {
+			return nil, fmt.Errorf("schema: field '%s': read $%x bytes but expected $%x", fields[i].Name, len(rsps[i].Data), reads[i].Size)
+		}
+		values[fields[i].Name] = fields[i].Decode(rsps[i].Data)
+	}
+	return
+}
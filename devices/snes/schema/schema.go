@@ -0,0 +1,182 @@
+// Package schema decodes memory into typed values described by schemas of fields, e.g. the WRAM layout of a game, so
+// that clients do not have to decode raw bytes themselves.
+package schema
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+	"sni/devices"
+	"sni/protos/sni"
+)
+
+// Schema describes fields of memory relative to a base address.
+type Schema struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// Base is the address fields are offset from, in AddressSpace; AddressSpace defaults to SnesABus and
+	// MemoryMapping is detected from the ROM header if not set:
+	Base          uint32 `yaml:"base"`
+	AddressSpace  string `yaml:"addressSpace"`
+	MemoryMapping string `yaml:"memoryMapping"`
+
+	Fields []Field `yaml:"fields"`
+
+	addressSpace  sni.AddressSpace
+	memoryMapping sni.MemoryMapping
+}
+
+// Field is a value, or an array of values if Count is set, at an offset from the schema's base address.
+type Field struct {
+	Name   string `yaml:"name"`
+	Type   Type   `yaml:"type"`
+	Offset uint32 `yaml:"offset"`
+	// Size is the size in bytes of "bytes" and "bitfield" fields; bitfields default to 1 byte:
+	Size uint32 `yaml:"size"`
+	// Count makes the field an array of Count values each Stride bytes apart; Stride defaults to the value size:
+	Count  uint32 `yaml:"count"`
+	Stride uint32 `yaml:"stride"`
+	// Bits names the bits of a "bitfield" field, numbered from the least significant bit of its little-endian value:
+	Bits map[string]uint32 `yaml:"bits"`
+}
+
+// Type is the type of a field's value.
+type Type string
+
+const (
+	U8    Type = "u8"
+	U16LE Type = "u16le"
+	U24LE Type = "u24le"
+	U32LE Type = "u32le"
+	S8    Type = "s8"
+	S16LE Type = "s16le"
+	// BCD8 and BCD16 are little-endian binary-coded decimals of 2 and 4 digits:
+	BCD8     Type = "bcd8"
+	BCD16    Type = "bcd16"
+	Bitfield Type = "bitfield"
+	Bytes    Type = "bytes"
+)
+
+// valueSize returns the size of a single value of the field in bytes.
+func (f *Field) valueSize() uint32 {
+	switch f.Type {
+	case U8, S8, BCD8:
+		return 1
+	case U16LE, S16LE, BCD16:
+		return 2
+	case U24LE:
+		return 3
+	case U32LE:
+		return 4
+	case Bitfield:
+		if f.Size == 0 {
+			return 1
+		}
+		return f.Size
+	default:
+		return f.Size
+	}
+}
+
+func (f *Field) stride() uint32 {
+	if f.Stride == 0 {
+		return f.valueSize()
+	}
+	return f.Stride
+}
+
+// span returns the number of bytes covered by the field including all elements of an array.
+func (f *Field) span() uint32 {
+	if f.Count == 0 {
+		return f.valueSize()
+	}
+	return f.stride()*(f.Count-1) + f.valueSize()
+}
+
+func (f *Field) validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("field must have a name")
+	}
+
+	switch f.Type {
+	case U8, U16LE, U24LE, U32LE, S8, S16LE, BCD8, BCD16:
+	case Bitfield:
+		if f.Size > 4 {
+			return fmt.Errorf("field '%s': bitfield size must be at most 4 bytes", f.Name)
+		}
+		if len(f.Bits) == 0 {
+			return fmt.Errorf("field '%s': bitfield must name its bits", f.Name)
+		}
+		for name, bit := range f.Bits {
+			if bit >= f.valueSize()*8 {
+				return fmt.Errorf("field '%s': bit '%s' is out of range", f.Name, name)
+			}
+		}
+	case Bytes:
+		if f.Size == 0 {
+			return fmt.Errorf("field '%s': bytes must have a size", f.Name)
+		}
+	default:
+		return fmt.Errorf("field '%s': unknown type '%s'", f.Name, f.Type)
+	}
+
+	if f.Count > 0 && f.stride() < f.valueSize() {
+		return fmt.Errorf("field '%s': stride must be at least the size of a value", f.Name)
+	}
+	if f.span() > maxFieldSize {
+		return fmt.Errorf("field '%s': must be at most $%x bytes", f.Name, maxFieldSize)
+	}
+	return nil
+}
+
+// maxFieldSize bounds the memory a single field may cover:
+const maxFieldSize = 0x1_0000
+
+// Parse reads and validates a schema from YAML.
+func Parse(data []byte) (s *Schema, err error) {
+	s = &Schema{}
+	if err = yaml.Unmarshal(data, s); err != nil {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("schema: %w", err))
+	}
+	if err = s.validate(); err != nil {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("schema: %w", err))
+	}
+	return
+}
+
+func (s *Schema) validate() error {
+	s.addressSpace = sni.AddressSpace_SnesABus
+	if s.AddressSpace != "" {
+		v, ok := sni.AddressSpace_value[s.AddressSpace]
+		if !ok {
+			return fmt.Errorf("unknown address space '%s'", s.AddressSpace)
+		}
+		s.addressSpace = sni.AddressSpace(v)
+	}
+
+	s.memoryMapping = sni.MemoryMapping_Unknown
+	if s.MemoryMapping != "" {
+		v, ok := sni.MemoryMapping_value[s.MemoryMapping]
+		if !ok {
+			return fmt.Errorf("unknown memory mapping '%s'", s.MemoryMapping)
+		}
+		s.memoryMapping = sni.MemoryMapping(v)
+	}
+
+	if len(s.Fields) == 0 {
+		return fmt.Errorf("schema must have fields")
+	}
+	names := make(map[string]bool, len(s.Fields))
+	for i := range s.Fields {
+		f := &s.Fields[i]
+		if err := f.validate(); err != nil {
+			return err
+		}
+		if names[f.Name] {
+			return fmt.Errorf("field '%s' is defined more than once", f.Name)
+		}
+		names[f.Name] = true
+	}
+	return nil
+}
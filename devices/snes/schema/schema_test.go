@@ -0,0 +1,139 @@
+package schema
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sni/cmd/sni/config"
+	"sni/internal/snestest"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+const testSchema = `
+name: test
+base: 0xF5F300
+addressSpace: FxPakPro
+fields:
+  - name: rupees
+    type: u16le
+    offset: 0x60
+  - name: health
+    type: u8
+    offset: 0x6D
+  - name: score
+    type: bcd16
+    offset: 0x10
+  - name: velocity
+    type: s16le
+    offset: 0x12
+  - name: items
+    type: bitfield
+    offset: 0x14
+    bits:
+      bow: 0
+      boomerang: 7
+  - name: keys
+    type: u8
+    offset: 0x20
+    count: 3
+    stride: 2
+  - name: position
+    type: u24le
+    offset: 0x30
+  - name: raw
+    type: bytes
+    offset: 0x40
+    size: 2
+`
+
+func TestRead(t *testing.T) {
+	s, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &snestest.PakMemory{}
+	wram := m.Memory[0xF5F300:]
+	copy(wram[0x60:], []byte{0x34, 0x12})
+	wram[0x6D] = 0x18
+	copy(wram[0x10:], []byte{0x78, 0x56})
+	copy(wram[0x12:], []byte{0xFE, 0xFF})
+	wram[0x14] = 0x80
+	copy(wram[0x20:], []byte{1, 0xFF, 2, 0xFF, 3})
+	copy(wram[0x30:], []byte{0x56, 0x34, 0x12})
+	copy(wram[0x40:], []byte{0xAB, 0xCD})
+
+	values, err := s.Read(context.Background(), m, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]Value{
+		"rupees":   uint64(0x1234),
+		"health":   uint64(0x18),
+		"score":    uint64(5678),
+		"velocity": int64(-2),
+		"items":    map[string]bool{"bow": false, "boomerang": true},
+		"keys":     []Value{uint64(1), uint64(2), uint64(3)},
+		"position": uint64(0x123456),
+		"raw":      []byte{0xAB, 0xCD},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %v but got %v", expected, values)
+	}
+
+	values, err = s.Read(context.Background(), m, []string{"health"})
+	if err != nil || len(values) != 1 || values["health"] != uint64(0x18) {
+		t.Fatalf("expected only health to be read: %v %v", values, err)
+	}
+	if _, err = s.Read(context.Background(), m, []string{"missing"}); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, yaml := range []string{
+		"fields: []",
+		"fields: [{name: a, type: u12}]",
+		"fields: [{name: a, type: u8}, {name: a, type: u8}]",
+		"fields: [{name: a, type: bytes}]",
+		"fields: [{name: a, type: bitfield, bits: {x: 8}}]",
+		"fields: [{name: a, type: u16le, count: 2, stride: 1}]",
+		"addressSpace: Nowhere\nfields: [{name: a, type: u8}]",
+	} {
+		if _, err := Parse([]byte(yaml)); !snestest.IsCode(err, codes.InvalidArgument) {
+			t.Errorf("Parse(%q): expected InvalidArgument but got %v", yaml, err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	oldDir := config.Dir
+	config.Dir = t.TempDir()
+	t.Cleanup(func() { config.Dir = oldDir })
+
+	if err := os.MkdirAll(Dir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(Dir(), "alttp.yml"), []byte("fields: [{name: health, type: u8}]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Load("alttp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "alttp" || len(s.Fields) != 1 {
+		t.Fatalf("unexpected schema %+v", s)
+	}
+
+	if _, err = Load("missing"); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+	if _, err = Load("../alttp"); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument but got %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"strings"
+)
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._ -]{0,127}$`)
+
+// Dir returns the directory schema files are loaded from:
+func Dir() string {
+	return filepath.Join(config.Dir, "schemas")
+}
+
+// Load reads the schema named `name` from "<name>.yaml" or "<name>.yml" in Dir.
+func Load(name string) (s *Schema, err error) {
+	if !validName.MatchString(name) || strings.Contains(name, "..") {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("schema: invalid name '%s'", name))
+	}
+
+	var data []byte
+	for _, ext := range []string{".yaml", ".yml"} {
+		data, err = os.ReadFile(filepath.Join(Dir(), name+ext))
+		if !os.IsNotExist(err) {
+			break
+		}
+	}
+	if os.IsNotExist(err) {
+		return nil, devices.WithCode(codes.NotFound, fmt.Errorf("schema: no schema named '%s' in %s", name, Dir()))
+	}
+	if err != nil {
+		return
+	}
+
+	if s, err = Parse(data); err != nil {
+		return
+	}
+	if s.Name == "" {
+		s.Name = name
+	}
+	return
+}
@@ -0,0 +1,209 @@
+// Package search implements stateful memory searches that narrow down the addresses holding a value by repeatedly
+// comparing memory against its previous contents or a given value, as used to find cheats.
+package search
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Predicate decides whether a candidate remains in the search given its current and previous values.
+type Predicate int
+
+const (
+	Unchanged Predicate = iota
+	Changed
+	Increased
+	Decreased
+	EqualToValue
+	NotEqualToValue
+	GreaterThanValue
+	LessThanValue
+)
+
+func (p Predicate) String() string {
+	switch p {
+	case Unchanged:
+		return "unchanged"
+	case Changed:
+		return "changed"
+	case Increased:
+		return "increased"
+	case Decreased:
+		return "decreased"
+	case EqualToValue:
+		return "equal to value"
+	case NotEqualToValue:
+		return "not equal to value"
+	case GreaterThanValue:
+		return "greater than value"
+	case LessThanValue:
+		return "less than value"
+	default:
+		return fmt.Sprintf("Predicate(%d)", int(p))
+	}
+}
+
+func (p Predicate) match(current, previous, value uint32) bool {
+	switch p {
+	case Unchanged:
+		return current == previous
+	case Changed:
+		return current != previous
+	case Increased:
+		return current > previous
+	case Decreased:
+		return current < previous
+	case EqualToValue:
+		return current == value
+	case NotEqualToValue:
+		return current != value
+	case GreaterThanValue:
+		return current > value
+	case LessThanValue:
+		return current < value
+	default:
+		return false
+	}
+}
+
+// Candidate is an address that still matches all predicates applied to a search.
+type Candidate struct {
+	Address       uint32
+	Value         uint32
+	PreviousValue uint32
+}
+
+// Session is a search over a region of memory of one device.
+type Session struct {
+	ID     string
+	Uri    string
+	Region devices.AddressTuple
+	Size   int
+	// Width is the size of each value in bytes; values are little-endian:
+	Width int
+
+	// lastUsed is in unix nanoseconds; kept outside mu so idle sessions can be found while one is being refined:
+	lastUsed atomic.Int64
+
+	mu       sync.Mutex
+	current  []byte
+	previous []byte
+	// candidates are offsets into the region in increasing order:
+	candidates []uint32
+}
+
+// Start reads the region and creates a search with every value in it as a candidate.
+func Start(ctx context.Context, memory devices.DeviceMemory, uri string, region devices.AddressTuple, size int, width int) (s *Session, err error) {
+	if width < 1 || width > 3 {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("search: width must be 1, 2 or 3 bytes"))
+	}
+	if size < width || size > maxSize {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("search: size must be between %d and $%x bytes", width, maxSize))
+	}
+
+	s = &Session{
+		Uri:    uri,
+		Region: region,
+		Size:   size,
+		Width:  width,
+	}
+	s.touch()
+	if s.current, err = s.read(ctx, memory); err != nil {
+		return nil, err
+	}
+	s.previous = s.current
+
+	s.candidates = make([]uint32, size-width+1)
+	for i := range s.candidates {
+		s.candidates[i] = uint32(i)
+	}
+
+	return
+}
+
+func (s *Session) read(ctx context.Context, memory devices.DeviceMemory) (data []byte, err error) {
+	var rsps []devices.MemoryReadResponse
+	rsps, err = memory.MultiReadMemory(ctx, devices.MemoryReadRequest{RequestAddress: s.Region, Size: s.Size})
+	if err != nil {
+		return
+	}
+	data = rsps[0].Data
+	if len(data) != s.Size {
+		return nil, fmt.Errorf("search: read $%x bytes but expected $%x", len(data), s.Size)
+	}
+	return
+}
+
+func (s *Session) valueAt(data []byte, offset uint32) (v uint32) {
+	for i := s.Width - 1; i >= 0; i-- {
+		v = v<<8 | uint32(data[offset+uint32(i)])
+	}
+	return
+}
+
+// Refine reads the region again and removes candidates that do not match the predicate. value is only used by
+// predicates that compare against a value.
+func (s *Session) Refine(ctx context.Context, memory devices.DeviceMemory, predicate Predicate, value uint32) (err error) {
+	if predicate < Unchanged || predicate > LessThanValue {
+		return devices.WithCode(codes.InvalidArgument, fmt.Errorf("search: unknown predicate %d", int(predicate)))
+	}
+
+	// hold the lock while reading so that concurrent refinements apply in order:
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := s.read(ctx, memory)
+	if err != nil {
+		return
+	}
+
+	s.touch()
+	s.previous, s.current = s.current, data
+
+	candidates := s.candidates[:0]
+	for _, offset := range s.candidates {
+		if predicate.match(s.valueAt(s.current, offset), s.valueAt(s.previous, offset), value) {
+			candidates = append(candidates, offset)
+		}
+	}
+	s.candidates = candidates
+
+	return
+}
+
+// Candidates returns the number of remaining candidates and up to limit of them starting from the given index.
+func (s *Session) Candidates(start int, limit int) (total int, candidates []Candidate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.touch()
+	total = len(s.candidates)
+	if start < 0 || start >= total || limit <= 0 {
+		return
+	}
+
+	end := min(start+limit, total)
+	candidates = make([]Candidate, 0, end-start)
+	for _, offset := range s.candidates[start:end] {
+		candidates = append(candidates, Candidate{
+			Address:       s.Region.Address + offset,
+			Value:         s.valueAt(s.current, offset),
+			PreviousValue: s.valueAt(s.previous, offset),
+		})
+	}
+	return
+}
+
+func (s *Session) touch() {
+	s.lastUsed.Store(time.Now().UnixNano())
+}
+
+func (s *Session) idleSince() time.Time {
+	return time.Unix(0, s.lastUsed.Load())
+}
@@ -0,0 +1,100 @@
+package search
+
+import (
+	"context"
+	"sni/devices"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+var wram = devices.AddressTuple{Address: 0xF5_0000, AddressSpace: sni.AddressSpace_FxPakPro}
+
+func TestSearchNarrowsToAddress(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+
+	// a 16-bit health value at $7E0400:
+	m.Memory[0xF5_0400], m.Memory[0xF5_0401] = 0x2C, 0x01
+
+	s, err := Start(ctx, m, "test:device", wram, 0x1000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total, _ := s.Candidates(0, 0); total != 0x1000-1 {
+		t.Fatalf("expected every offset to be a candidate but got %d", total)
+	}
+
+	// nothing happened:
+	if err = s.Refine(ctx, m, Unchanged, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// took damage, and some unrelated value changed too:
+	m.Memory[0xF5_0400], m.Memory[0xF5_0401] = 0x04, 0x01
+	m.Memory[0xF5_0800] = 0x10
+	if err = s.Refine(ctx, m, Decreased, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Refine(ctx, m, EqualToValue, 0x104); err != nil {
+		t.Fatal(err)
+	}
+
+	total, candidates := s.Candidates(0, 10)
+	if total != 1 || len(candidates) != 1 {
+		t.Fatalf("expected 1 candidate but got %d: %+v", total, candidates)
+	}
+	if expected := (Candidate{Address: 0xF5_0400, Value: 0x104, PreviousValue: 0x104}); candidates[0] != expected {
+		t.Fatalf("expected %+v but got %+v", expected, candidates[0])
+	}
+}
+
+func TestCandidatesPaging(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+
+	s, err := Start(ctx, m, "test:device", wram, 0x10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	total, candidates := s.Candidates(12, 10)
+	if total != 0x10 || len(candidates) != 4 || candidates[0].Address != 0xF5_000C {
+		t.Fatalf("unexpected page: total=%d %+v", total, candidates)
+	}
+	if _, candidates = s.Candidates(0x10, 10); len(candidates) != 0 {
+		t.Fatalf("expected no candidates past the end but got %+v", candidates)
+	}
+}
+
+func TestStartValidates(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+
+	if _, err := Start(ctx, m, "test:device", wram, 0x10, 4); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument for width but got %v", err)
+	}
+	if _, err := Start(ctx, m, "test:device", wram, maxSize+1, 1); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument for size but got %v", err)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	s := &Session{}
+	s.touch()
+	Add(s)
+	if s.ID == "" {
+		t.Fatal("expected session to be assigned an id")
+	}
+
+	if got, err := Get(s.ID); err != nil || got != s {
+		t.Fatalf("expected session to be found: %v", err)
+	}
+
+	End(s.ID)
+	if _, err := Get(s.ID); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package search
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sync"
+	"time"
+)
+
+const (
+	// maxSize is the largest region that can be searched:
+	maxSize = 0x10_0000
+	// maxSessions is the number of searches kept at once; the least recently used search is ended to make room:
+	maxSessions = 16
+	// idleTimeout is how long a search is kept without being used:
+	idleTimeout = time.Minute * 30
+)
+
+var (
+	sessionsMu sync.Mutex
+	sessions   = make(map[string]*Session)
+)
+
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Add assigns the session an id and keeps it until it is ended, goes unused for too long or is evicted to make room
+// for newer sessions.
+func Add(s *Session) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	pruneUnderLock(time.Now())
+	for len(sessions) >= maxSessions {
+		var oldest *Session
+		for _, other := range sessions {
+			if oldest == nil || other.idleSince().Before(oldest.idleSince()) {
+				oldest = other
+			}
+		}
+		delete(sessions, oldest.ID)
+	}
+
+	s.ID = newID()
+	sessions[s.ID] = s
+}
+
+// Get finds a session by id.
+func Get(id string) (s *Session, err error) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	pruneUnderLock(time.Now())
+	s, ok := sessions[id]
+	if !ok {
+		return nil, devices.WithCode(codes.NotFound, fmt.Errorf("search: no search with id '%s'; it may have expired", id))
+	}
+	return s, nil
+}
+
+// End discards a session.
+func End(id string) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	delete(sessions, id)
+}
+
+func pruneUnderLock(now time.Time) {
+	for id, s := range sessions {
+		if now.Sub(s.idleSince()) > idleTimeout {
+			delete(sessions, id)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+// Package snapshot captures memory regions of a SNES device to versioned files and restores them later.
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/alttpo/snes"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"strings"
+	"time"
+)
+
+// Version is the version of the snapshot file format:
+const Version = 1
+
+// Region is a named range of memory in the FX Pak Pro address space.
+type Region struct {
+	Name    string `json:"name"`
+	Address uint32 `json:"address"`
+	Size    uint32 `json:"size"`
+
+	// Writable is false for regions that can be captured but not written back by any device, e.g. the FX Pak Pro
+	// only exposes read-only copies of VRAM, APU RAM, CGRAM and OAM:
+	Writable bool `json:"-"`
+}
+
+// sramAddress is the start of SRAM in the FX Pak Pro address space; its size depends on the cartridge:
+const sramAddress = 0xE0_0000
+
+// sramMaxSize is the size of the SRAM window in the FX Pak Pro address space:
+const sramMaxSize = 0x10_0000
+
+// Regions lists all regions that can be captured, in the order they are captured and restored. The size of SRAM is
+// taken from the ROM header.
+var Regions = []Region{
+	{Name: "WRAM", Address: 0xF5_0000, Size: 0x2_0000, Writable: true},
+	{Name: "SRAM", Address: sramAddress, Writable: true},
+	{Name: "VRAM", Address: 0xF7_0000, Size: 0x1_0000},
+	{Name: "APU", Address: 0xF8_0000, Size: 0x1_0000},
+	{Name: "CGRAM", Address: 0xF9_0000, Size: 0x200},
+	{Name: "OAM", Address: 0xF9_0200, Size: 0x220},
+}
+
+// DefaultRegions are captured when no regions are requested:
+var DefaultRegions = []string{"WRAM", "SRAM"}
+
+// RegionData is the captured contents of a Region.
+type RegionData struct {
+	Region
+	Data []byte `json:"data"`
+}
+
+// Snapshot is the captured contents of memory regions of a device at one point in time.
+type Snapshot struct {
+	Version int               `json:"version"`
+	Name    string            `json:"name"`
+	Uri     string            `json:"uri"`
+	Created time.Time         `json:"created"`
+	Mapping sni.MemoryMapping `json:"mapping"`
+
+	// RomTitle and RomChecksum identify the game the snapshot was taken from:
+	RomTitle    string `json:"romTitle"`
+	RomChecksum uint16 `json:"romChecksum"`
+
+	Regions []RegionData `json:"regions"`
+}
+
+// Region returns the captured region with the given name, if present.
+func (s *Snapshot) Region(name string) (*RegionData, bool) {
+	for i := range s.Regions {
+		if strings.EqualFold(s.Regions[i].Name, name) {
+			return &s.Regions[i], true
+		}
+	}
+	return nil, false
+}
+
+// RegionByName finds a region by its case-insensitive name. The size of SRAM is not known without a ROM header and
+// is returned as 0.
+func RegionByName(name string) (Region, bool) {
+	for _, region := range Regions {
+		if strings.EqualFold(name, region.Name) {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+func hasName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// SRAMSize returns the size of cartridge SRAM declared by the ROM header, or 0 if the cartridge has none.
+func SRAMSize(header *snes.Header) uint32 {
+	if header.RAMSize == 0 {
+		return 0
+	}
+	size := header.RAMSizeBytes()
+	if size > sramMaxSize {
+		size = sramMaxSize
+	}
+	return size
+}
+
+// resolveRegions returns the regions with the given names, or DefaultRegions if none are given, with sizes
+// determined by the ROM header. SRAM is left out if the cartridge has none.
+func resolveRegions(names []string, header *snes.Header) (regions []Region, err error) {
+	if len(names) == 0 {
+		names = DefaultRegions
+	}
+
+	regions = make([]Region, 0, len(names))
+	for _, region := range Regions {
+		if !hasName(names, region.Name) {
+			continue
+		}
+
+		if region.Address == sramAddress {
+			region.Size = SRAMSize(header)
+			if region.Size == 0 {
+				continue
+			}
+		}
+		regions = append(regions, region)
+	}
+
+	// reject names that match no region:
+	for _, name := range names {
+		if _, ok := RegionByName(name); !ok {
+			return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("snapshot: unknown region '%s'", name))
+		}
+	}
+
+	return
+}
+
+// DetectRom detects the memory mapping and reads the ROM header of the game currently running on the device.
+func DetectRom(ctx context.Context, memory devices.DeviceMemory) (memoryMapping sni.MemoryMapping, header *snes.Header, err error) {
+	var headerBytes []byte
+	memoryMapping, _, headerBytes, err = mapping.Detect(ctx, memory, nil, nil)
+	if err != nil {
+		return
+	}
+
+	header = &snes.Header{}
+	if err = header.ReadHeader(bytes.NewReader(headerBytes)); err != nil {
+		return
+	}
+	return
+}
+
+// RomTitle returns the title from the ROM header without padding.
+func RomTitle(header *snes.Header) string {
+	return strings.TrimRight(string(header.Title[:]), " \x00")
+}
+
+// Take captures the named regions, or DefaultRegions if none are named, of the game currently running on the device.
+func Take(ctx context.Context, memory devices.DeviceMemory, uri string, name string, regionNames []string) (s *Snapshot, err error) {
+	memoryMapping, header, err := DetectRom(ctx, memory)
+	if err != nil {
+		return
+	}
+
+	regions, err := resolveRegions(regionNames, header)
+	if err != nil {
+		return
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(regions))
+	for _, region := range regions {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       region.Address,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Size: int(region.Size),
+		})
+	}
+
+	var rsps []devices.MemoryReadResponse
+	if len(reads) > 0 {
+		rsps, err = memory.MultiReadMemory(ctx, reads...)
+		if err != nil {
+			return
+		}
+	}
+
+	s = &Snapshot{
+		Version:     Version,
+		Name:        name,
+		Uri:         uri,
+		Created:     time.Now(),
+		Mapping:     memoryMapping,
+		RomTitle:    RomTitle(header),
+		RomChecksum: header.CheckSum,
+		Regions:     make([]RegionData, 0, len(regions)),
+	}
+	for i, region := range regions {
+		s.Regions = append(s.Regions, RegionData{Region: region, Data: rsps[i].Data})
+	}
+
+	return
+}
+
+// isWritable reports whether a captured region can be written back to a device.
+func isWritable(region Region) bool {
+	known, ok := RegionByName(region.Name)
+	return ok && known.Writable
+}
+
+// Restore writes the named regions of the snapshot, or all of its regions if none are named, back to the device. The
+// snapshot must have been taken from the game currently running on the device unless force is true. Naming a region
+// that cannot be written is an error; when no regions are named such regions are skipped and returned in skipped.
+func (s *Snapshot) Restore(ctx context.Context, memory devices.DeviceMemory, regionNames []string, force bool) (restored []Region, skipped []Region, err error) {
+	memoryMapping, header, err := DetectRom(ctx, memory)
+	if err != nil {
+		return
+	}
+
+	if !force && (header.CheckSum != s.RomChecksum || RomTitle(header) != s.RomTitle) {
+		return nil, nil, devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf(
+				"snapshot: '%s' was taken from '%s' (checksum $%04x) but '%s' (checksum $%04x) is running",
+				s.Name,
+				s.RomTitle,
+				s.RomChecksum,
+				RomTitle(header),
+				header.CheckSum,
+			),
+		)
+	}
+
+	for _, name := range regionNames {
+		region, ok := s.Region(name)
+		if !ok {
+			return nil, nil, devices.WithCode(codes.NotFound, fmt.Errorf("snapshot: '%s' does not contain region '%s'", s.Name, name))
+		}
+		if !isWritable(region.Region) {
+			return nil, nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("snapshot: region '%s' is read-only and cannot be restored", region.Name))
+		}
+	}
+
+	writes := make([]devices.MemoryWriteRequest, 0, len(s.Regions))
+	for _, region := range s.Regions {
+		if len(regionNames) > 0 && !hasName(regionNames, region.Name) {
+			continue
+		}
+		if !isWritable(region.Region) {
+			skipped = append(skipped, region.Region)
+			continue
+		}
+
+		writes = append(writes, devices.MemoryWriteRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       region.Address,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Data: region.Data,
+		})
+		restored = append(restored, region.Region)
+	}
+
+	if len(writes) == 0 {
+		return
+	}
+	if _, err = memory.MultiWriteMemory(ctx, writes...); err != nil {
+		return nil, nil, err
+	}
+
+	return
+}
+
+// Change is a contiguous range of bytes that differ between two captures of the same region.
+type Change struct {
+	Offset uint32
+	Before []byte
+	After  []byte
+}
+
+// RegionDiff lists the changes to one region between two snapshots.
+type RegionDiff struct {
+	Region  Region
+	Changes []Change
+	// ChangedBytes is the total number of bytes that differ:
+	ChangedBytes uint32
+}
+
+// Diff compares the regions present in both snapshots. Runs of changed bytes separated by fewer than mergeGap
+// unchanged bytes are reported as a single change.
+func Diff(before, after *Snapshot, regionNames []string, mergeGap int) (diffs []RegionDiff) {
+	for _, b := range before.Regions {
+		if len(regionNames) > 0 && !hasName(regionNames, b.Name) {
+			continue
+		}
+
+		a, ok := after.Region(b.Name)
+		if !ok {
+			continue
+		}
+
+		diffs = append(diffs, diffRegion(b, *a, mergeGap))
+	}
+	return
+}
+
+func diffRegion(before, after RegionData, mergeGap int) (d RegionDiff) {
+	d.Region = before.Region
+
+	n := len(before.Data)
+	if len(after.Data) < n {
+		n = len(after.Data)
+	}
+
+	start, end := -1, -1
+	flush := func() {
+		if start < 0 {
+			return
+		}
+		d.Changes = append(d.Changes, Change{
+			Offset: uint32(start),
+			Before: append([]byte(nil), before.Data[start:end]...),
+			After:  append([]byte(nil), after.Data[start:end]...),
+		})
+		start, end = -1, -1
+	}
+
+	for i := 0; i < n; i++ {
+		if before.Data[i] == after.Data[i] {
+			continue
+		}
+		d.ChangedBytes++
+		if start >= 0 && i-end >= mergeGap {
+			flush()
+		}
+		if start < 0 {
+			start = i
+		}
+		end = i + 1
+	}
+	flush()
+
+	return
+}
@@ -0,0 +1,154 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"sni/cmd/sni/config"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestTakeRestoreDiff(t *testing.T) {
+	oldDir := config.Dir
+	config.Dir = t.TempDir()
+	t.Cleanup(func() { config.Dir = oldDir })
+
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	m.LoadROM("PRACTICE HACK", 0x1234, 0x03)
+	m.Memory[0xF5_0010] = 0x11
+	m.Memory[0xE0_1FFF] = 0x22
+
+	s, err := Take(ctx, m, "test:device", "start", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.RomTitle != "PRACTICE HACK" || s.RomChecksum != 0x1234 || s.Mapping != sni.MemoryMapping_LoROM {
+		t.Fatalf("unexpected rom identity: %q $%04x %s", s.RomTitle, s.RomChecksum, s.Mapping)
+	}
+	if len(s.Regions) != 2 {
+		t.Fatalf("expected WRAM and SRAM but got %d regions", len(s.Regions))
+	}
+	sram, ok := s.Region("sram")
+	if !ok || sram.Size != 0x2000 || len(sram.Data) != 0x2000 || sram.Data[0x1FFF] != 0x22 {
+		t.Fatalf("unexpected SRAM region %+v", sram.Region)
+	}
+	if err = Save(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// change memory and diff against the stored snapshot:
+	m.Memory[0xF5_0010] = 0x33
+	m.Memory[0xF5_0012] = 0x44
+	m.Memory[0xF5_0100] = 0x55
+	now, err := Take(ctx, m, "test:device", "now", []string{"WRAM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load("start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	diffs := Diff(loaded, now, nil, 4)
+	if len(diffs) != 1 || diffs[0].Region.Name != "WRAM" {
+		t.Fatalf("expected only WRAM to be compared: %+v", diffs)
+	}
+	if diffs[0].ChangedBytes != 3 || len(diffs[0].Changes) != 2 {
+		t.Fatalf("expected 3 bytes changed in 2 changes: %+v", diffs[0])
+	}
+	if c := diffs[0].Changes[0]; c.Offset != 0x10 || !bytes.Equal(c.Before, []byte{0x11, 0, 0}) || !bytes.Equal(c.After, []byte{0x33, 0, 0x44}) {
+		t.Fatalf("unexpected first change %+v", c)
+	}
+
+	// restore puts memory back:
+	if _, _, err = loaded.Restore(ctx, m, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if m.Memory[0xF5_0010] != 0x11 || m.Memory[0xF5_0012] != 0 || m.Memory[0xF5_0100] != 0 {
+		t.Fatal("expected WRAM to be restored")
+	}
+
+	// restoring onto a different game requires force:
+	m.LoadROM("OTHER GAME", 0x4321, 0x03)
+	if _, _, err = loaded.Restore(ctx, m, nil, false); !snestest.IsCode(err, codes.FailedPrecondition) {
+		t.Fatalf("expected FailedPrecondition but got %v", err)
+	}
+	if _, _, err = loaded.Restore(ctx, m, []string{"WRAM"}, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = loaded.Restore(ctx, m, []string{"VRAM"}, true); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+
+	list, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Name != "start" {
+		t.Fatalf("unexpected snapshot list %+v", list)
+	}
+}
+
+func TestRestoreReadOnlyRegions(t *testing.T) {
+	ctx := context.Background()
+	m := &snestest.PakMemory{}
+	m.LoadROM("PRACTICE HACK", 0x1234, 0x00)
+	m.Memory[0xF5_0010] = 0x11
+	m.Memory[0xF7_0010] = 0x22
+
+	s, err := Take(ctx, m, "test:device", "start", []string{"WRAM", "VRAM"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Memory[0xF5_0010] = 0
+	m.Memory[0xF7_0010] = 0
+
+	// naming a read-only region fails without writing anything:
+	if _, _, err = s.Restore(ctx, m, []string{"WRAM", "VRAM"}, false); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument but got %v", err)
+	}
+	if m.Memory[0xF5_0010] != 0 {
+		t.Fatal("expected nothing to be restored")
+	}
+
+	// restoring all regions skips read-only ones:
+	restored, skipped, err := s.Restore(ctx, m, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != 1 || restored[0].Name != "WRAM" || len(skipped) != 1 || skipped[0].Name != "VRAM" {
+		t.Fatalf("unexpected restored %+v and skipped %+v", restored, skipped)
+	}
+	if m.Memory[0xF5_0010] != 0x11 || m.Memory[0xF7_0010] != 0 {
+		t.Fatal("expected only WRAM to be restored")
+	}
+}
+
+func TestTakeWithoutSRAM(t *testing.T) {
+	m := &snestest.PakMemory{}
+	m.LoadROM("NO SAVE", 0x5555, 0x00)
+
+	s, err := Take(context.Background(), m, "test:device", "x", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Region("SRAM"); ok || len(s.Regions) != 1 {
+		t.Fatalf("expected only WRAM to be captured: %+v", s.Regions)
+	}
+
+	if _, err = Take(context.Background(), m, "test:device", "x", []string{"ROM"}); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument for unknown region but got %v", err)
+	}
+}
+
+func TestInvalidNames(t *testing.T) {
+	for _, name := range []string{"", "../escape", "a/b", `a\b`, ".hidden"} {
+		if _, err := Load(name); !snestest.IsCode(err, codes.InvalidArgument) {
+			t.Errorf("Load(%q): expected InvalidArgument but got %v", name, err)
+		}
+	}
+}
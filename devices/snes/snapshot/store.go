@@ -0,0 +1,119 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const fileExt = ".json"
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._ -]{0,127}$`)
+
+// Dir returns the directory snapshots are stored in:
+func Dir() string {
+	return filepath.Join(config.Dir, "snapshots")
+}
+
+// NewName generates a name for a snapshot taken at the given time.
+func NewName(t time.Time) string {
+	return t.Format("20060102-150405.000")
+}
+
+func pathFor(name string) (string, error) {
+	if !validName.MatchString(name) || strings.Contains(name, "..") {
+		return "", devices.WithCode(codes.InvalidArgument, fmt.Errorf("snapshot: invalid name '%s'", name))
+	}
+	return filepath.Join(Dir(), name+fileExt), nil
+}
+
+// Save writes the snapshot to Dir, replacing any snapshot with the same name.
+func Save(s *Snapshot) (err error) {
+	var path string
+	if path, err = pathFor(s.Name); err != nil {
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	var b []byte
+	if b, err = json.Marshal(s); err != nil {
+		return
+	}
+
+	// write to a temporary file first so an existing snapshot is never left half-written:
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, b, 0644); err != nil {
+		return
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+	}
+	return
+}
+
+// Load reads the named snapshot from Dir.
+func Load(name string) (s *Snapshot, err error) {
+	var path string
+	if path, err = pathFor(name); err != nil {
+		return
+	}
+	return load(path)
+}
+
+func load(path string) (s *Snapshot, err error) {
+	var b []byte
+	if b, err = os.ReadFile(path); err != nil {
+		if os.IsNotExist(err) {
+			err = devices.WithCode(codes.NotFound, fmt.Errorf("snapshot: '%s' not found", strings.TrimSuffix(filepath.Base(path), fileExt)))
+		}
+		return
+	}
+
+	s = &Snapshot{}
+	if err = json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("snapshot: %s: %w", path, err)
+	}
+	if s.Version != Version {
+		return nil, fmt.Errorf("snapshot: %s: unsupported version %d", path, s.Version)
+	}
+	return
+}
+
+// List reads all snapshots in Dir, most recent first. Files that cannot be read are skipped.
+func List() (snapshots []*Snapshot, err error) {
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(Dir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != fileExt {
+			continue
+		}
+
+		s, lerr := load(filepath.Join(Dir(), entry.Name()))
+		if lerr != nil {
+			continue
+		}
+		snapshots = append(snapshots, s)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Created.After(snapshots[j].Created)
+	})
+	return
+}
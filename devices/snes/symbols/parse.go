@@ -0,0 +1,173 @@
+// Package symbols loads assembler symbol files so that memory can be addressed by label instead of by address.
+package symbols
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"sni/devices"
+	"strconv"
+	"strings"
+)
+
+// Format is the format of a symbol file.
+type Format int
+
+const (
+	// DetectFormat detects the format from the contents of the file:
+	DetectFormat Format = iota
+	// WLA is the WLA-DX symbol file format also written by bsnes and asar (--symbols=wla): labels are listed as
+	// "BB:AAAA name" in the [labels] section.
+	WLA
+	// Nocash is the no$sns symbol file format also written by asar (--symbols=nocash): labels are listed as
+	// "BBAAAA name" without sections.
+	Nocash
+	// CA65 is the debug info file format written by ld65 (--dbgfile).
+	CA65
+)
+
+func (f Format) String() string {
+	switch f {
+	case DetectFormat:
+		return "detect"
+	case WLA:
+		return "wla"
+	case Nocash:
+		return "nocash"
+	case CA65:
+		return "ca65"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// Table maps labels to SNES A-bus addresses. When a label is defined more than once, the first definition is kept.
+type Table struct {
+	Labels map[string]uint32
+}
+
+func (t *Table) add(label string, address uint32) {
+	if _, ok := t.Labels[label]; ok {
+		return
+	}
+	t.Labels[label] = address
+}
+
+// Parse reads a symbol file of the given format, detecting the format if DetectFormat is given.
+func Parse(data []byte, format Format) (t *Table, err error) {
+	if format == DetectFormat {
+		format = detect(data)
+	}
+
+	t = &Table{Labels: make(map[string]uint32)}
+	switch format {
+	case WLA:
+		err = parseWLA(t, bytes.NewReader(data), true)
+	case Nocash:
+		err = parseWLA(t, bytes.NewReader(data), false)
+	case CA65:
+		err = parseCA65(t, bytes.NewReader(data))
+	default:
+		err = fmt.Errorf("unknown format %s", format)
+	}
+	if err != nil {
+		return nil, devices.WithCode(codes.InvalidArgument, fmt.Errorf("symbols: %s: %w", format, err))
+	}
+	return
+}
+
+func detect(data []byte) Format {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "version\t") || strings.HasPrefix(line, "sym\t") {
+			return CA65
+		}
+		if strings.EqualFold(line, "[labels]") {
+			return WLA
+		}
+	}
+	return Nocash
+}
+
+// parseWLA parses "BB:AAAA name" or "BBAAAA name" lines; if sectioned is true, only lines in the [labels] section are
+// read.
+func parseWLA(t *Table, r io.Reader, sectioned bool) error {
+	scanner := bufio.NewScanner(r)
+	inLabels := !sectioned
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, ';'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if sectioned {
+				inLabels = strings.EqualFold(line, "[labels]")
+			}
+			continue
+		}
+		if !inLabels {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return fmt.Errorf("line %d: expected address and label", lineNumber)
+		}
+
+		address, err := strconv.ParseUint(strings.Replace(fields[0], ":", "", 1), 16, 24)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid address '%s'", lineNumber, fields[0])
+		}
+		t.add(fields[1], uint32(address))
+	}
+	return scanner.Err()
+}
+
+// parseCA65 reads the labels from "sym" lines of a ld65 debug info file, e.g.
+// `sym	id=0,name="Reset",addrsize=absolute,scope=0,def=1,val=0x8000,seg=0,type=lab`.
+func parseCA65(t *Table, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		kind, attributes, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok || kind != "sym" {
+			continue
+		}
+
+		var name, val, typ string
+		for _, attribute := range strings.Split(attributes, ",") {
+			key, value, _ := strings.Cut(attribute, "=")
+			switch key {
+			case "name":
+				name = strings.Trim(value, `"`)
+			case "val":
+				val = value
+			case "type":
+				typ = value
+			}
+		}
+		// skip constants and imports which are not addresses of their own:
+		if typ != "lab" || name == "" || val == "" {
+			continue
+		}
+
+		address, err := strconv.ParseUint(val, 0, 32)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid value '%s'", lineNumber, val)
+		}
+		t.add(name, uint32(address))
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,223 @@
+package symbols
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
+	"sni/devices/snes/snapshot"
+	"sni/protos/sni"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	tablesMu sync.RWMutex
+	// byUri holds the tables loaded for a device:
+	byUri = make(map[string]*Table)
+	// byRom holds the tables loaded for a ROM, identified by the lowercase hex SHA-1 of the ROM:
+	byRom = make(map[string]*Table)
+)
+
+// LoadForDevice makes the table's labels available to requests to the device, replacing any table previously loaded
+// for it.
+func LoadForDevice(uri string, t *Table) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	byUri[uri] = t
+}
+
+// LoadForRom makes the table's labels available to requests to any device running the ROM with the given SHA-1, as
+// computed by romhash, replacing any table previously loaded for it.
+func LoadForRom(sha1 string, t *Table) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	byRom[strings.ToLower(sha1)] = t
+}
+
+// UnloadForDevice removes the table loaded for the device and reports whether there was one.
+func UnloadForDevice(uri string) bool {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	_, ok := byUri[uri]
+	delete(byUri, uri)
+	return ok
+}
+
+// UnloadForRom removes the table loaded for the ROM and reports whether there was one.
+func UnloadForRom(sha1 string) bool {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	sha1 = strings.ToLower(sha1)
+	_, ok := byRom[sha1]
+	delete(byRom, sha1)
+	return ok
+}
+
+func lookupDevice(uri string, label string) (address uint32, ok bool) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	if t := byUri[uri]; t != nil {
+		address, ok = t.Labels[label]
+	}
+	return
+}
+
+func lookupRom(sha1 string, label string) (address uint32, ok bool) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	if t := byRom[sha1]; t != nil {
+		address, ok = t.Labels[label]
+	}
+	return
+}
+
+func hasRomTables() bool {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	return len(byRom) > 0
+}
+
+// ParseExpression splits a label expression such as "Link_Health+2" or "Buffer-$10" into its label and offset.
+// Offsets are decimal, or hexadecimal when prefixed with '$' or "0x".
+func ParseExpression(expr string) (label string, offset int64, err error) {
+	expr = strings.TrimSpace(expr)
+	label = expr
+
+	if i := strings.LastIndexAny(expr, "+-"); i > 0 {
+		number := strings.TrimSpace(expr[i+1:])
+		if strings.HasPrefix(number, "$") {
+			number = "0x" + number[1:]
+		}
+		var n uint64
+		if n, err = strconv.ParseUint(number, 0, 24); err != nil {
+			err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("symbols: invalid offset in '%s'", expr))
+			return
+		}
+		label, offset = strings.TrimSpace(expr[:i]), int64(n)
+		if expr[i] == '-' {
+			offset = -offset
+		}
+	}
+
+	if label == "" {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("symbols: empty label in '%s'", expr))
+	}
+	return
+}
+
+// Resolver resolves label expressions for requests to one device. The ROM header is read at most once, and only if
+// needed to detect the memory mapping. The ROM is only hashed if a table is loaded for a ROM, and its hash is cached
+// per device by romhash until the ROM changes.
+type Resolver struct {
+	ctx    context.Context
+	memory devices.DeviceMemory
+	uri    string
+
+	detected bool
+	mapping  sni.MemoryMapping
+
+	hashed bool
+	sha1   string
+}
+
+func NewResolver(ctx context.Context, memory devices.DeviceMemory, uri string) *Resolver {
+	return &Resolver{ctx: ctx, memory: memory, uri: uri}
+}
+
+func (r *Resolver) detect() (err error) {
+	if r.detected {
+		return
+	}
+
+	mapping, _, err := snapshot.DetectRom(r.ctx, r.memory)
+	if err != nil {
+		return
+	}
+	r.detected, r.mapping = true, mapping
+	return
+}
+
+func (r *Resolver) hash() (err error) {
+	if r.hashed {
+		return
+	}
+
+	var h romhash.Hashes
+	if h, _, err = romhash.ForDevice(r.ctx, r.memory, r.uri); err != nil {
+		return
+	}
+	r.hashed, r.sha1 = true, h.SHA1String()
+	return
+}
+
+// lookup finds the label in the table loaded for the device and then in the table loaded for its ROM.
+func (r *Resolver) lookup(label string) (address uint32, ok bool, err error) {
+	address, ok = lookupDevice(r.uri, label)
+	if !ok && hasRomTables() {
+		if err = r.hash(); err != nil {
+			return
+		}
+		address, ok = lookupRom(r.sha1, label)
+	}
+	return
+}
+
+// Resolve resolves a label expression to an address on the SNES A bus using the tables loaded for the device or for
+// the ROM it is running. The whole expression is looked up as a label first since labels may contain '+' and '-',
+// e.g. ca65 cheap local labels, and only then split into a label and an offset. If memoryMapping is Unknown it is
+// detected from the ROM header.
+func (r *Resolver) Resolve(expr string, memoryMapping sni.MemoryMapping) (a devices.AddressTuple, err error) {
+	whole := strings.TrimSpace(expr)
+	if whole == "" {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("symbols: empty label in '%s'", expr))
+		return
+	}
+
+	label := whole
+	var address uint32
+	var offset int64
+	var ok bool
+	if address, ok, err = r.lookup(whole); err != nil {
+		return
+	}
+	if !ok {
+		if label, offset, err = ParseExpression(whole); err != nil {
+			// not an expression with an offset either:
+			err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: label '%s' is not defined", whole))
+			return
+		}
+		if label != whole {
+			if address, ok, err = r.lookup(label); err != nil {
+				return
+			}
+		}
+	}
+	if !ok {
+		err = devices.WithCode(codes.NotFound, fmt.Errorf("symbols: label '%s' is not defined", label))
+		return
+	}
+
+	if memoryMapping == sni.MemoryMapping_Unknown {
+		if err = r.detect(); err != nil {
+			return
+		}
+		memoryMapping = r.mapping
+	}
+
+	a = devices.AddressTuple{
+		Address:       uint32(int64(address)+offset) & 0xFF_FFFF,
+		AddressSpace:  sni.AddressSpace_SnesABus,
+		MemoryMapping: memoryMapping,
+	}
+
+	// make sure the address maps to memory the device can access:
+	if _, err = mapping.TranslateAddress(a, sni.AddressSpace_FxPakPro); err != nil {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("symbols: '%s' resolved to %s: %w", expr, &a, err))
+		return
+	}
+	return
+}
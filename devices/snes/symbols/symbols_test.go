@@ -0,0 +1,156 @@
+package symbols
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"sni/devices"
+	"sni/devices/snes/romhash"
+	"sni/internal/snestest"
+	"sni/protos/sni"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		data     string
+		format   Format
+		expected map[string]uint32
+	}{
+		{
+			name: "wla",
+			data: "; wla symbolic information file\n" +
+				"[information]\nversion 2\n" +
+				"[labels]\n00:8000 Reset\n7e:0010 Link_Health ; comment\n00:8000 Reset\n" +
+				"[definitions]\n00000001 SOME_CONSTANT\n",
+			format:   DetectFormat,
+			expected: map[string]uint32{"Reset": 0x00_8000, "Link_Health": 0x7E_0010},
+		},
+		{
+			name:     "nocash",
+			data:     "008000 Reset\n7E0010 Link_Health\n",
+			format:   DetectFormat,
+			expected: map[string]uint32{"Reset": 0x00_8000, "Link_Health": 0x7E_0010},
+		},
+		{
+			name: "ca65",
+			data: "version\tmajor=2,minor=0\n" +
+				"sym\tid=0,name=\"Reset\",addrsize=absolute,scope=0,def=1,val=0x8000,seg=0,type=lab\n" +
+				"sym\tid=1,name=\"Link_Health\",addrsize=far,scope=0,def=2,val=0x7E0010,type=lab\n" +
+				"sym\tid=2,name=\"CONSTANT\",addrsize=zeropage,scope=0,def=3,val=0x1,type=equ\n",
+			format:   DetectFormat,
+			expected: map[string]uint32{"Reset": 0x8000, "Link_Health": 0x7E_0010},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			table, err := Parse([]byte(tc.data), tc.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(table.Labels) != len(tc.expected) {
+				t.Fatalf("expected %d labels but got %v", len(tc.expected), table.Labels)
+			}
+			for label, address := range tc.expected {
+				if got, ok := table.Labels[label]; !ok || got != address {
+					t.Errorf("%s = $%06x, expected $%06x", label, got, address)
+				}
+			}
+		})
+	}
+
+	if _, err := Parse([]byte("[labels]\nzz:8000 Reset\n"), WLA); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument but got %v", err)
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	for _, tc := range []struct {
+		expr   string
+		label  string
+		offset int64
+	}{
+		{"Link_Health", "Link_Health", 0},
+		{"Link_Health+2", "Link_Health", 2},
+		{" Buffer - $10 ", "Buffer", -0x10},
+		{"Buffer+0x20", "Buffer", 0x20},
+	} {
+		label, offset, err := ParseExpression(tc.expr)
+		if err != nil || label != tc.label || offset != tc.offset {
+			t.Errorf("ParseExpression(%q) = %q, %d, %v", tc.expr, label, offset, err)
+		}
+	}
+
+	for _, expr := range []string{"", "Link_Health+", "Link_Health+x"} {
+		if _, _, err := ParseExpression(expr); !snestest.IsCode(err, codes.InvalidArgument) {
+			t.Errorf("ParseExpression(%q): expected InvalidArgument but got %v", expr, err)
+		}
+	}
+}
+
+func TestResolver(t *testing.T) {
+	m := &snestest.PakMemory{}
+	m.LoadROM("SYMBOLS", 0xBEEF, 0x00)
+	uri := "test:symbols"
+
+	rom := m.Memory[:0x10_0000]
+	romSha1 := sha1.Sum(rom)
+
+	LoadForDevice(uri, &Table{Labels: map[string]uint32{"Link_Health": 0x7E_0010, "Buffer-End": 0x7E_0200}})
+	LoadForRom(strings.ToUpper(hex.EncodeToString(romSha1[:])), &Table{Labels: map[string]uint32{"Reset": 0x00_8000}})
+	// a ROM with the same header checksum but different contents:
+	other := sha1.Sum(append([]byte{0xFF}, rom[1:]...))
+	LoadForRom(hex.EncodeToString(other[:]), &Table{Labels: map[string]uint32{"Other": 0x00_8000}})
+	t.Cleanup(func() {
+		UnloadForDevice(uri)
+		UnloadForRom(hex.EncodeToString(romSha1[:]))
+		UnloadForRom(hex.EncodeToString(other[:]))
+		romhash.Forget(uri)
+	})
+
+	r := NewResolver(context.Background(), m, uri)
+
+	a, err := r.Resolve("Link_Health+2", sni.MemoryMapping_HiROM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (devices.AddressTuple{Address: 0x7E_0012, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_HiROM}); a != expected {
+		t.Fatalf("expected %s but got %s", &expected, &a)
+	}
+
+	// labels not loaded for the device are found in the symbols loaded for its ROM, and the mapping is detected:
+	if a, err = r.Resolve("Reset", sni.MemoryMapping_Unknown); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (devices.AddressTuple{Address: 0x00_8000, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM}); a != expected {
+		t.Fatalf("expected %s but got %s", &expected, &a)
+	}
+
+	// tables are found by the ROM's hash, not by its header checksum:
+	if _, err = r.Resolve("Other", sni.MemoryMapping_LoROM); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound but got %v", err)
+	}
+
+	// labels containing '+' or '-' are looked up whole before an offset is split off:
+	for expr, address := range map[string]uint32{"Buffer-End": 0x7E_0200, "Buffer-End+2": 0x7E_0202, "Link_Health-$10": 0x7E_0000} {
+		if a, err = r.Resolve(expr, sni.MemoryMapping_LoROM); err != nil {
+			t.Fatal(err)
+		}
+		if a.Address != address {
+			t.Errorf("%s: expected $%06x but got %s", expr, address, &a)
+		}
+	}
+
+	for _, expr := range []string{"Missing", "Missing+x"} {
+		if _, err = r.Resolve(expr, sni.MemoryMapping_LoROM); !snestest.IsCode(err, codes.NotFound) {
+			t.Fatalf("%s: expected NotFound but got %v", expr, err)
+		}
+	}
+
+	if !UnloadForDevice(uri) || UnloadForDevice(uri) {
+		t.Fatal("expected device symbols to be unloaded once")
+	}
+}
@@ -0,0 +1,80 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// WriteMismatch describes a write whose data did not read back from the device as written.
+type WriteMismatch struct {
+	// Index of the write in the verified writes:
+	Index int
+	// Offset of the first mismatched byte from the write's address:
+	Offset int
+	// Count of mismatched bytes:
+	Count int
+	// Actual contents of memory read back from the device for the whole write:
+	Actual []byte
+}
+
+// VerifyWrites reads back the memory written by writes and reports the writes whose data did not read back as
+// written. rsps are the responses to the writes; conditional writes that failed to compare are not verified.
+func VerifyWrites(ctx context.Context, device DeviceMemory, writes []MemoryWriteRequest, rsps []MemoryWriteResponse) (mismatches []WriteMismatch, err error) {
+	reads := make([]MemoryReadRequest, 0, len(writes))
+	readIndex := make([]int, 0, len(writes))
+	for j, write := range writes {
+		if j < len(rsps) && rsps[j].CompareFailed {
+			continue
+		}
+		if len(write.Data) == 0 {
+			continue
+		}
+		reads = append(reads, MemoryReadRequest{
+			RequestAddress: write.RequestAddress,
+			Size:           len(write.Data),
+		})
+		readIndex = append(readIndex, j)
+	}
+	if len(reads) == 0 {
+		return
+	}
+
+	var rrsps []MemoryReadResponse
+	rrsps, err = device.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		return
+	}
+	if actual, expected := len(rrsps), len(reads); actual != expected {
+		err = WithCode(codes.Internal, fmt.Errorf("verify read must have equal number of responses and requests; actual %d expected %d", actual, expected))
+		return
+	}
+
+	for i, j := range readIndex {
+		data, actual := writes[j].Data, rrsps[i].Data
+
+		first, count := -1, 0
+		for k := range data {
+			if k < len(actual) && actual[k] == data[k] {
+				continue
+			}
+			if first < 0 {
+				first = k
+			}
+			count++
+		}
+		if count == 0 {
+			continue
+		}
+
+		mismatches = append(mismatches, WriteMismatch{
+			Index:  j,
+			Offset: first,
+			Count:  count,
+			Actual: actual,
+		})
+	}
+
+	return
+}
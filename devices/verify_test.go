@@ -0,0 +1,52 @@
+package devices
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVerifyWrites(t *testing.T) {
+	fake, device := newFakeMemoryDevice(t)
+
+	writes := []MemoryWriteRequest{
+		{RequestAddress: AddressTuple{Address: 0x10}, Data: []byte{0x01, 0x02, 0x03, 0x04}},
+		{RequestAddress: AddressTuple{Address: 0x20}, Data: []byte{0x05, 0x06}},
+	}
+	rsps, err := device.MultiWriteMemory(context.Background(), writes...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mismatches, err := VerifyWrites(context.Background(), device, writes, rsps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("expected no mismatches; got %+v", mismatches)
+	}
+
+	// simulate a write that partially failed to land:
+	fake.poke(0x11, 0xFF)
+	fake.poke(0x13, 0xFF)
+
+	mismatches, err = VerifyWrites(context.Background(), device, writes, rsps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 1 {
+		t.Fatalf("expected 1 mismatch; got %+v", mismatches)
+	}
+	if m := mismatches[0]; m.Index != 0 || m.Offset != 1 || m.Count != 2 {
+		t.Fatalf("unexpected mismatch %+v", m)
+	}
+
+	// writes that failed to compare are not verified:
+	rsps[0].CompareFailed = true
+	mismatches, err = VerifyWrites(context.Background(), device, writes, rsps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("expected no mismatches; got %+v", mismatches)
+	}
+}
@@ -0,0 +1,98 @@
+// Package snestest provides a fake device memory with an SNES game loaded for tests of packages that access SNES
+// memory.
+package snestest
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+)
+
+// PakMemory is a device memory in the FX Pak Pro address space. Requests in other address spaces are translated to
+// it with their memory mapping.
+type PakMemory struct {
+	Memory [0x100_0000]byte
+	// Reads is the number of reads requested:
+	Reads int
+	// Writes are the writes requested, in order:
+	Writes []devices.MemoryWriteRequest
+}
+
+// LoadROM writes the header of a 1MiB LoROM game with the given title, checksum and SRAM size code at $00:FFB0.
+func (m *PakMemory) LoadROM(title string, checksum uint16, ramSize byte) {
+	h := m.Memory[0x7FB0 : 0x7FB0+0x50]
+	clear(h)
+	copy(h[0x10:0x25], bytes.Repeat([]byte{' '}, 21))
+	copy(h[0x10:0x25], title)
+	h[0x25] = 0x20 // LoROM
+	h[0x26] = 0x02 // ROM+RAM+battery
+	h[0x27] = 0x0A
+	h[0x28] = ramSize
+	h[0x29] = 0x01
+	h[0x2A] = 0x33
+	binary.LittleEndian.PutUint16(h[0x2C:], ^checksum)
+	binary.LittleEndian.PutUint16(h[0x2E:], checksum)
+	// all vectors point into ROM:
+	for i := 0x30; i < 0x50; i += 2 {
+		binary.LittleEndian.PutUint16(h[i:], 0x8000)
+	}
+}
+
+// Header returns the ROM header at $00:FFB0 of a LoROM game.
+func (m *PakMemory) Header() []byte {
+	return m.Memory[0x7FB0:0x8000]
+}
+
+func (m *PakMemory) RequiresMemoryMappingForAddressSpace(context.Context, sni.AddressSpace) (bool, error) {
+	return false, nil
+}
+
+func (m *PakMemory) RequiresMemoryMappingForAddress(context.Context, devices.AddressTuple) (bool, error) {
+	return false, nil
+}
+
+func (m *PakMemory) MultiReadMemory(_ context.Context, reads ...devices.MemoryReadRequest) (rsps []devices.MemoryReadResponse, err error) {
+	for _, read := range reads {
+		var address uint32
+		if address, err = mapping.TranslateAddress(read.RequestAddress, sni.AddressSpace_FxPakPro); err != nil {
+			return
+		}
+		data := make([]byte, read.Size)
+		copy(data, m.Memory[address:])
+		rsps = append(rsps, devices.MemoryReadResponse{
+			RequestAddress: read.RequestAddress,
+			DeviceAddress:  devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro, MemoryMapping: read.RequestAddress.MemoryMapping},
+			Data:           data,
+		})
+		m.Reads++
+	}
+	return
+}
+
+func (m *PakMemory) MultiWriteMemory(_ context.Context, writes ...devices.MemoryWriteRequest) (rsps []devices.MemoryWriteResponse, err error) {
+	for _, write := range writes {
+		var address uint32
+		if address, err = mapping.TranslateAddress(write.RequestAddress, sni.AddressSpace_FxPakPro); err != nil {
+			return
+		}
+		copy(m.Memory[address:], write.Data)
+		m.Writes = append(m.Writes, write)
+		rsps = append(rsps, devices.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress:  devices.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro, MemoryMapping: write.RequestAddress.MemoryMapping},
+			Size:           len(write.Data),
+		})
+	}
+	return
+}
+
+// IsCode reports whether err is a devices.CodedError with the given code.
+func IsCode(err error, code codes.Code) bool {
+	var coded *devices.CodedError
+	return errors.As(err, &coded) && coded.Code == code
+}
@@ -92,7 +92,12 @@ const (
 	MemoryMapping_HiROM   MemoryMapping = 1
 	MemoryMapping_LoROM   MemoryMapping = 2
 	MemoryMapping_ExHiROM MemoryMapping = 3 // (48-64Mbit)
-	MemoryMapping_SA1     MemoryMapping = 4 // TODO: BSX = 5;
+	MemoryMapping_SA1     MemoryMapping = 4
+	MemoryMapping_BSX     MemoryMapping = 5 // BS-X (Satellaview) memory pack
+	MemoryMapping_ExLoROM MemoryMapping = 6 // (>32Mbit)
+	MemoryMapping_SuperFX MemoryMapping = 7 // GSU
+	MemoryMapping_SDD1    MemoryMapping = 8
+	MemoryMapping_SPC7110 MemoryMapping = 9
 )
 
 // Enum value maps for MemoryMapping.
@@ -103,6 +108,11 @@ var (
 		2: "LoROM",
 		3: "ExHiROM",
 		4: "SA1",
+		5: "BSX",
+		6: "ExLoROM",
+		7: "SuperFX",
+		8: "SDD1",
+		9: "SPC7110",
 	}
 	MemoryMapping_value = map[string]int32{
 		"Unknown": 0,
@@ -110,6 +120,11 @@ var (
 		"LoROM":   2,
 		"ExHiROM": 3,
 		"SA1":     4,
+		"BSX":     5,
+		"ExLoROM": 6,
+		"SuperFX": 7,
+		"SDD1":    8,
+		"SPC7110": 9,
 	}
 )
 
@@ -140,6 +155,289 @@ func (MemoryMapping) EnumDescriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{1}
 }
 
+// predicate a memory search candidate must match to be kept:
+type SearchPredicate int32
+
+const (
+	// value is the same as when last read
+	SearchPredicate_Unchanged SearchPredicate = 0
+	// value is different from when last read
+	SearchPredicate_Changed SearchPredicate = 1
+	// value is greater than when last read
+	SearchPredicate_Increased SearchPredicate = 2
+	// value is less than when last read
+	SearchPredicate_Decreased SearchPredicate = 3
+	// value is equal to the given value
+	SearchPredicate_EqualToValue SearchPredicate = 4
+	// value is not equal to the given value
+	SearchPredicate_NotEqualToValue SearchPredicate = 5
+	// value is greater than the given value
+	SearchPredicate_GreaterThanValue SearchPredicate = 6
+	// value is less than the given value
+	SearchPredicate_LessThanValue SearchPredicate = 7
+)
+
+// Enum value maps for SearchPredicate.
+var (
+	SearchPredicate_name = map[int32]string{
+		0: "Unchanged",
+		1: "Changed",
+		2: "Increased",
+		3: "Decreased",
+		4: "EqualToValue",
+		5: "NotEqualToValue",
+		6: "GreaterThanValue",
+		7: "LessThanValue",
+	}
+	SearchPredicate_value = map[string]int32{
+		"Unchanged":        0,
+		"Changed":          1,
+		"Increased":        2,
+		"Decreased":        3,
+		"EqualToValue":     4,
+		"NotEqualToValue":  5,
+		"GreaterThanValue": 6,
+		"LessThanValue":    7,
+	}
+)
+
+func (x SearchPredicate) Enum() *SearchPredicate {
+	p := new(SearchPredicate)
+	*p = x
+	return p
+}
+
+func (x SearchPredicate) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchPredicate) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchPredicate) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[2]
+}
+
+func (x SearchPredicate) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchPredicate.Descriptor instead.
+func (SearchPredicate) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{2}
+}
+
+// format of a cheat code:
+type CheatFormat int32
+
+const (
+	// "XXXX-XXXX" in the Game Genie alphabet
+	CheatFormat_GameGenie CheatFormat = 0
+	// "AAAAAAVV" in hexadecimal
+	CheatFormat_ProActionReplay CheatFormat = 1
+)
+
+// Enum value maps for CheatFormat.
+var (
+	CheatFormat_name = map[int32]string{
+		0: "GameGenie",
+		1: "ProActionReplay",
+	}
+	CheatFormat_value = map[string]int32{
+		"GameGenie":       0,
+		"ProActionReplay": 1,
+	}
+)
+
+func (x CheatFormat) Enum() *CheatFormat {
+	p := new(CheatFormat)
+	*p = x
+	return p
+}
+
+func (x CheatFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheatFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[3].Descriptor()
+}
+
+func (CheatFormat) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[3]
+}
+
+func (x CheatFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheatFormat.Descriptor instead.
+func (CheatFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{3}
+}
+
+// format of a symbol file:
+type SymbolFormat int32
+
+const (
+	// detect the format from the contents of the file
+	SymbolFormat_DetectSymbolFormat SymbolFormat = 0
+	// WLA-DX symbol file, also written by bsnes and asar with --symbols=wla: "BB:AAAA label" lines in a [labels] section
+	SymbolFormat_WlaSymbols SymbolFormat = 1
+	// no$sns symbol file, also written by asar with --symbols=nocash: "BBAAAA label" lines
+	SymbolFormat_NocashSymbols SymbolFormat = 2
+	// ld65 debug info file written with --dbgfile
+	SymbolFormat_Ca65DebugInfo SymbolFormat = 3
+)
+
+// Enum value maps for SymbolFormat.
+var (
+	SymbolFormat_name = map[int32]string{
+		0: "DetectSymbolFormat",
+		1: "WlaSymbols",
+		2: "NocashSymbols",
+		3: "Ca65DebugInfo",
+	}
+	SymbolFormat_value = map[string]int32{
+		"DetectSymbolFormat": 0,
+		"WlaSymbols":         1,
+		"NocashSymbols":      2,
+		"Ca65DebugInfo":      3,
+	}
+)
+
+func (x SymbolFormat) Enum() *SymbolFormat {
+	p := new(SymbolFormat)
+	*p = x
+	return p
+}
+
+func (x SymbolFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SymbolFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[4].Descriptor()
+}
+
+func (SymbolFormat) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[4]
+}
+
+func (x SymbolFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SymbolFormat.Descriptor instead.
+func (SymbolFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{4}
+}
+
+type PatchFormat int32
+
+const (
+	// detect the format from the patch's magic bytes
+	PatchFormat_DetectPatchFormat PatchFormat = 0
+	// IPS: "PATCH" followed by records of data to write at offsets up to 16MiB
+	PatchFormat_IpsPatch PatchFormat = 1
+	// BPS: "BPS1"; validates the CRC32 of the source ROM before applying
+	PatchFormat_BpsPatch PatchFormat = 2
+	// UPS: "UPS1"; validates the CRC32 of the source ROM before applying
+	PatchFormat_UpsPatch PatchFormat = 3
+)
+
+// Enum value maps for PatchFormat.
+var (
+	PatchFormat_name = map[int32]string{
+		0: "DetectPatchFormat",
+		1: "IpsPatch",
+		2: "BpsPatch",
+		3: "UpsPatch",
+	}
+	PatchFormat_value = map[string]int32{
+		"DetectPatchFormat": 0,
+		"IpsPatch":          1,
+		"BpsPatch":          2,
+		"UpsPatch":          3,
+	}
+)
+
+func (x PatchFormat) Enum() *PatchFormat {
+	p := new(PatchFormat)
+	*p = x
+	return p
+}
+
+func (x PatchFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[5].Descriptor()
+}
+
+func (PatchFormat) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[5]
+}
+
+func (x PatchFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchFormat.Descriptor instead.
+func (PatchFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{5}
+}
+
+type PatchTarget int32
+
+const (
+	// write the patched bytes live to the ROM space of the device, in the FX Pak Pro address space
+	PatchTarget_PatchRomMemory PatchTarget = 0
+	// read a ROM file from the device's filesystem, patch it and write it back
+	PatchTarget_PatchFile PatchTarget = 1
+)
+
+// Enum value maps for PatchTarget.
+var (
+	PatchTarget_name = map[int32]string{
+		0: "PatchRomMemory",
+		1: "PatchFile",
+	}
+	PatchTarget_value = map[string]int32{
+		"PatchRomMemory": 0,
+		"PatchFile":      1,
+	}
+)
+
+func (x PatchTarget) Enum() *PatchTarget {
+	p := new(PatchTarget)
+	*p = x
+	return p
+}
+
+func (x PatchTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[6].Descriptor()
+}
+
+func (PatchTarget) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[6]
+}
+
+func (x PatchTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchTarget.Descriptor instead.
+func (PatchTarget) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{6}
+}
+
 // capabilities of a device
 type DeviceCapability int32
 
@@ -216,11 +514,11 @@ func (x DeviceCapability) String() string {
 }
 
 func (DeviceCapability) Descriptor() protoreflect.EnumDescriptor {
-	return file_sni_proto_enumTypes[2].Descriptor()
+	return file_sni_proto_enumTypes[7].Descriptor()
 }
 
 func (DeviceCapability) Type() protoreflect.EnumType {
-	return &file_sni_proto_enumTypes[2]
+	return &file_sni_proto_enumTypes[7]
 }
 
 func (x DeviceCapability) Number() protoreflect.EnumNumber {
@@ -229,7 +527,7 @@ func (x DeviceCapability) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DeviceCapability.Descriptor instead.
 func (DeviceCapability) EnumDescriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{2}
+	return file_sni_proto_rawDescGZIP(), []int{7}
 }
 
 // fields to query from DeviceInfo.FetchFields
@@ -245,6 +543,19 @@ const (
 	Field_RomFileName   Field = 40
 	Field_RomHashType   Field = 41
 	Field_RomHashValue  Field = 42
+	// hashes of the ROM computed by SNI reading the ROM from the device; lowercase hex:
+	Field_RomCRC32 Field = 43
+	Field_RomSHA1  Field = 44
+	Field_RomMD5   Field = 45
+	// the game identified by matching the ROM against the DAT files in the `dats` directory of the SNI config
+	// directory; empty if not found. GameName is the full name e.g. "Super Metroid (Japan, USA) (En,Ja)",
+	// GameRegion e.g. "Japan, USA" and GameRevision e.g. "1" for "(Rev 1)", "0" for the original release:
+	Field_GameName     Field = 60
+	Field_GameRegion   Field = 61
+	Field_GameRevision Field = 62
+	// how the game was matched: "sha1", "md5" or "crc32" when the ROM hash matched, or "title" when only the title,
+	// region and version in the ROM header did, e.g. for patched ROMs; a title match may be a different release:
+	Field_GameMatch Field = 63
 )
 
 // Enum value maps for Field.
@@ -259,6 +570,13 @@ var (
 		40: "RomFileName",
 		41: "RomHashType",
 		42: "RomHashValue",
+		43: "RomCRC32",
+		44: "RomSHA1",
+		45: "RomMD5",
+		60: "GameName",
+		61: "GameRegion",
+		62: "GameRevision",
+		63: "GameMatch",
 	}
 	Field_value = map[string]int32{
 		"DeviceName":    0,
@@ -270,6 +588,13 @@ var (
 		"RomFileName":   40,
 		"RomHashType":   41,
 		"RomHashValue":  42,
+		"RomCRC32":      43,
+		"RomSHA1":       44,
+		"RomMD5":        45,
+		"GameName":      60,
+		"GameRegion":    61,
+		"GameRevision":  62,
+		"GameMatch":     63,
 	}
 )
 
@@ -284,11 +609,11 @@ func (x Field) String() string {
 }
 
 func (Field) Descriptor() protoreflect.EnumDescriptor {
-	return file_sni_proto_enumTypes[3].Descriptor()
+	return file_sni_proto_enumTypes[8].Descriptor()
 }
 
 func (Field) Type() protoreflect.EnumType {
-	return &file_sni_proto_enumTypes[3]
+	return &file_sni_proto_enumTypes[8]
 }
 
 func (x Field) Number() protoreflect.EnumNumber {
@@ -297,7 +622,56 @@ func (x Field) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Field.Descriptor instead.
 func (Field) EnumDescriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{3}
+	return file_sni_proto_rawDescGZIP(), []int{8}
+}
+
+type DeviceEventType int32
+
+const (
+	DeviceEventType_DeviceAdded   DeviceEventType = 0
+	DeviceEventType_DeviceRemoved DeviceEventType = 1
+	DeviceEventType_DeviceChanged DeviceEventType = 2
+)
+
+// Enum value maps for DeviceEventType.
+var (
+	DeviceEventType_name = map[int32]string{
+		0: "DeviceAdded",
+		1: "DeviceRemoved",
+		2: "DeviceChanged",
+	}
+	DeviceEventType_value = map[string]int32{
+		"DeviceAdded":   0,
+		"DeviceRemoved": 1,
+		"DeviceChanged": 2,
+	}
+)
+
+func (x DeviceEventType) Enum() *DeviceEventType {
+	p := new(DeviceEventType)
+	*p = x
+	return p
+}
+
+func (x DeviceEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[9].Descriptor()
+}
+
+func (DeviceEventType) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[9]
+}
+
+func (x DeviceEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceEventType.Descriptor instead.
+func (DeviceEventType) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{9}
 }
 
 type DirEntryType int32
@@ -330,11 +704,11 @@ func (x DirEntryType) String() string {
 }
 
 func (DirEntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_sni_proto_enumTypes[4].Descriptor()
+	return file_sni_proto_enumTypes[10].Descriptor()
 }
 
 func (DirEntryType) Type() protoreflect.EnumType {
-	return &file_sni_proto_enumTypes[4]
+	return &file_sni_proto_enumTypes[10]
 }
 
 func (x DirEntryType) Number() protoreflect.EnumNumber {
@@ -343,7 +717,7 @@ func (x DirEntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DirEntryType.Descriptor instead.
 func (DirEntryType) EnumDescriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{4}
+	return file_sni_proto_rawDescGZIP(), []int{10}
 }
 
 type DevicesRequest struct {
@@ -441,6 +815,63 @@ func (x *DevicesResponse) GetDevices() []*DevicesResponse_Device {
 	return nil
 }
 
+type WatchDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes since the previous response; the first response lists all devices detected as added:
+	Events []*WatchDevicesResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// full list of devices detected after the events were applied:
+	Devices []*DevicesResponse_Device `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *WatchDevicesResponse) Reset() {
+	*x = WatchDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevicesResponse) ProtoMessage() {}
+
+func (x *WatchDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevicesResponse.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchDevicesResponse) GetEvents() []*WatchDevicesResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WatchDevicesResponse) GetDevices() []*DevicesResponse_Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
 type ResetSystemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -452,7 +883,7 @@ type ResetSystemRequest struct {
 func (x *ResetSystemRequest) Reset() {
 	*x = ResetSystemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[2]
+		mi := &file_sni_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +896,7 @@ func (x *ResetSystemRequest) String() string {
 func (*ResetSystemRequest) ProtoMessage() {}
 
 func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[2]
+	mi := &file_sni_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +909,7 @@ func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
 func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{2}
+	return file_sni_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ResetSystemRequest) GetUri() string {
@@ -499,7 +930,7 @@ type ResetSystemResponse struct {
 func (x *ResetSystemResponse) Reset() {
 	*x = ResetSystemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[3]
+		mi := &file_sni_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +943,7 @@ func (x *ResetSystemResponse) String() string {
 func (*ResetSystemResponse) ProtoMessage() {}
 
 func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[3]
+	mi := &file_sni_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +956,7 @@ func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
 func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{3}
+	return file_sni_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ResetSystemResponse) GetUri() string {
@@ -546,7 +977,7 @@ type ResetToMenuRequest struct {
 func (x *ResetToMenuRequest) Reset() {
 	*x = ResetToMenuRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[4]
+		mi := &file_sni_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +990,7 @@ func (x *ResetToMenuRequest) String() string {
 func (*ResetToMenuRequest) ProtoMessage() {}
 
 func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[4]
+	mi := &file_sni_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +1003,7 @@ func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
 func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{4}
+	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResetToMenuRequest) GetUri() string {
@@ -593,7 +1024,7 @@ type ResetToMenuResponse struct {
 func (x *ResetToMenuResponse) Reset() {
 	*x = ResetToMenuResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[5]
+		mi := &file_sni_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +1037,7 @@ func (x *ResetToMenuResponse) String() string {
 func (*ResetToMenuResponse) ProtoMessage() {}
 
 func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[5]
+	mi := &file_sni_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +1050,7 @@ func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
 func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{5}
+	return file_sni_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResetToMenuResponse) GetUri() string {
@@ -642,7 +1073,7 @@ type PauseEmulationRequest struct {
 func (x *PauseEmulationRequest) Reset() {
 	*x = PauseEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[6]
+		mi := &file_sni_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +1086,7 @@ func (x *PauseEmulationRequest) String() string {
 func (*PauseEmulationRequest) ProtoMessage() {}
 
 func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[6]
+	mi := &file_sni_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +1099,7 @@ func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
 func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{6}
+	return file_sni_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PauseEmulationRequest) GetUri() string {
@@ -697,7 +1128,7 @@ type PauseEmulationResponse struct {
 func (x *PauseEmulationResponse) Reset() {
 	*x = PauseEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[7]
+		mi := &file_sni_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +1141,7 @@ func (x *PauseEmulationResponse) String() string {
 func (*PauseEmulationResponse) ProtoMessage() {}
 
 func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[7]
+	mi := &file_sni_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +1154,7 @@ func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
 func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{7}
+	return file_sni_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PauseEmulationResponse) GetUri() string {
@@ -751,7 +1182,7 @@ type PauseToggleEmulationRequest struct {
 func (x *PauseToggleEmulationRequest) Reset() {
 	*x = PauseToggleEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[8]
+		mi := &file_sni_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -764,7 +1195,7 @@ func (x *PauseToggleEmulationRequest) String() string {
 func (*PauseToggleEmulationRequest) ProtoMessage() {}
 
 func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[8]
+	mi := &file_sni_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +1208,7 @@ func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
 func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{8}
+	return file_sni_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PauseToggleEmulationRequest) GetUri() string {
@@ -798,7 +1229,7 @@ type PauseToggleEmulationResponse struct {
 func (x *PauseToggleEmulationResponse) Reset() {
 	*x = PauseToggleEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[9]
+		mi := &file_sni_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -811,7 +1242,7 @@ func (x *PauseToggleEmulationResponse) String() string {
 func (*PauseToggleEmulationResponse) ProtoMessage() {}
 
 func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[9]
+	mi := &file_sni_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1255,7 @@ func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
 func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{9}
+	return file_sni_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PauseToggleEmulationResponse) GetUri() string {
@@ -850,7 +1281,7 @@ type DetectMemoryMappingRequest struct {
 func (x *DetectMemoryMappingRequest) Reset() {
 	*x = DetectMemoryMappingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[10]
+		mi := &file_sni_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -863,7 +1294,7 @@ func (x *DetectMemoryMappingRequest) String() string {
 func (*DetectMemoryMappingRequest) ProtoMessage() {}
 
 func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[10]
+	mi := &file_sni_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1307,7 @@ func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
 func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{10}
+	return file_sni_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DetectMemoryMappingRequest) GetUri() string {
@@ -917,7 +1348,7 @@ type DetectMemoryMappingResponse struct {
 func (x *DetectMemoryMappingResponse) Reset() {
 	*x = DetectMemoryMappingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[11]
+		mi := &file_sni_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1361,7 @@ func (x *DetectMemoryMappingResponse) String() string {
 func (*DetectMemoryMappingResponse) ProtoMessage() {}
 
 func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[11]
+	mi := &file_sni_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1374,7 @@ func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
 func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{11}
+	return file_sni_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DetectMemoryMappingResponse) GetUri() string {
@@ -974,6 +1405,291 @@ func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
 	return nil
 }
 
+type RomInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to parse
+	// if not provided, the header will be read from the device
+	RomHeader00FFB0 []byte `protobuf:"bytes,2,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
+	// set to sum the whole ROM read from the device to check the checksum; this takes a few seconds on an FX Pak Pro
+	// the first time for each ROM
+	SumRom bool `protobuf:"varint,3,opt,name=sumRom,proto3" json:"sumRom,omitempty"`
+}
+
+func (x *RomInfoRequest) Reset() {
+	*x = RomInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RomInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RomInfoRequest) ProtoMessage() {}
+
+func (x *RomInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RomInfoRequest.ProtoReflect.Descriptor instead.
+func (*RomInfoRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RomInfoRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RomInfoRequest) GetRomHeader00FFB0() []byte {
+	if x != nil {
+		return x.RomHeader00FFB0
+	}
+	return nil
+}
+
+func (x *RomInfoRequest) GetSumRom() bool {
+	if x != nil {
+		return x.SumRom
+	}
+	return false
+}
+
+type RomInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the memory mapping mode detected from the header, as returned by MappingDetect
+	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	Confidence    bool          `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// the title with trailing padding removed
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// map mode byte at $FFD5 e.g. $20 for LoROM, $31 for FastROM HiROM
+	MapMode uint32 `protobuf:"varint,5,opt,name=mapMode,proto3" json:"mapMode,omitempty"`
+	FastRom bool   `protobuf:"varint,6,opt,name=fastRom,proto3" json:"fastRom,omitempty"`
+	// chipset byte at $FFD6 and its description e.g. "ROM+SuperFX+RAM+battery"
+	Chipset     uint32 `protobuf:"varint,7,opt,name=chipset,proto3" json:"chipset,omitempty"`
+	ChipsetName string `protobuf:"bytes,8,opt,name=chipsetName,proto3" json:"chipsetName,omitempty"`
+	// sizes in bytes as declared by the header; 0 if absent
+	RomSize          uint32 `protobuf:"varint,9,opt,name=romSize,proto3" json:"romSize,omitempty"`
+	SramSize         uint32 `protobuf:"varint,10,opt,name=sramSize,proto3" json:"sramSize,omitempty"`
+	ExpansionRamSize uint32 `protobuf:"varint,11,opt,name=expansionRamSize,proto3" json:"expansionRamSize,omitempty"`
+	// destination code at $FFD9 and its name e.g. "North America"
+	Region     uint32 `protobuf:"varint,12,opt,name=region,proto3" json:"region,omitempty"`
+	RegionName string `protobuf:"bytes,13,opt,name=regionName,proto3" json:"regionName,omitempty"`
+	// developer ID at $FFDA; $33 means the two character `makerCode` at $FFB0 is used instead
+	DeveloperId uint32 `protobuf:"varint,14,opt,name=developerId,proto3" json:"developerId,omitempty"`
+	MakerCode   string `protobuf:"bytes,15,opt,name=makerCode,proto3" json:"makerCode,omitempty"`
+	// mask ROM version at $FFDB
+	Version            uint32 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	Checksum           uint32 `protobuf:"varint,17,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ChecksumComplement uint32 `protobuf:"varint,18,opt,name=checksumComplement,proto3" json:"checksumComplement,omitempty"`
+	// true if the checksum and its complement agree; the ROM contents are not summed
+	ChecksumComplementValid bool `protobuf:"varint,19,opt,name=checksumComplementValid,proto3" json:"checksumComplementValid,omitempty"`
+	// the ROM header parsed, as returned by MappingDetect
+	RomHeader00FFB0 []byte `protobuf:"bytes,20,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
+	// only set if sumRom was requested: true if the ROM read from the device sums to the checksum
+	ChecksumValid *bool `protobuf:"varint,21,opt,name=checksumValid,proto3,oneof" json:"checksumValid,omitempty"`
+}
+
+func (x *RomInfoResponse) Reset() {
+	*x = RomInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RomInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RomInfoResponse) ProtoMessage() {}
+
+func (x *RomInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RomInfoResponse.ProtoReflect.Descriptor instead.
+func (*RomInfoResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RomInfoResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RomInfoResponse) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *RomInfoResponse) GetConfidence() bool {
+	if x != nil {
+		return x.Confidence
+	}
+	return false
+}
+
+func (x *RomInfoResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RomInfoResponse) GetMapMode() uint32 {
+	if x != nil {
+		return x.MapMode
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetFastRom() bool {
+	if x != nil {
+		return x.FastRom
+	}
+	return false
+}
+
+func (x *RomInfoResponse) GetChipset() uint32 {
+	if x != nil {
+		return x.Chipset
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetChipsetName() string {
+	if x != nil {
+		return x.ChipsetName
+	}
+	return ""
+}
+
+func (x *RomInfoResponse) GetRomSize() uint32 {
+	if x != nil {
+		return x.RomSize
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetSramSize() uint32 {
+	if x != nil {
+		return x.SramSize
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetExpansionRamSize() uint32 {
+	if x != nil {
+		return x.ExpansionRamSize
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetRegion() uint32 {
+	if x != nil {
+		return x.Region
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *RomInfoResponse) GetDeveloperId() uint32 {
+	if x != nil {
+		return x.DeveloperId
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetMakerCode() string {
+	if x != nil {
+		return x.MakerCode
+	}
+	return ""
+}
+
+func (x *RomInfoResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetChecksum() uint32 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetChecksumComplement() uint32 {
+	if x != nil {
+		return x.ChecksumComplement
+	}
+	return 0
+}
+
+func (x *RomInfoResponse) GetChecksumComplementValid() bool {
+	if x != nil {
+		return x.ChecksumComplementValid
+	}
+	return false
+}
+
+func (x *RomInfoResponse) GetRomHeader00FFB0() []byte {
+	if x != nil {
+		return x.RomHeader00FFB0
+	}
+	return nil
+}
+
+func (x *RomInfoResponse) GetChecksumValid() bool {
+	if x != nil && x.ChecksumValid != nil {
+		return *x.ChecksumValid
+	}
+	return false
+}
+
 type ReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -983,12 +1699,16 @@ type ReadMemoryRequest struct {
 	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
 	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
 	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// optional label expression, e.g. "Link_Health+2", resolved with the symbols loaded for the device or its ROM; if
+	// set, `requestAddress` and `requestAddressSpace` are ignored and the label's SnesABus address is read instead.
+	// `requestMemoryMapping` is detected if Unknown.
+	RequestLabel string `protobuf:"bytes,5,opt,name=requestLabel,proto3" json:"requestLabel,omitempty"`
 }
 
 func (x *ReadMemoryRequest) Reset() {
 	*x = ReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[12]
+		mi := &file_sni_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1001,7 +1721,7 @@ func (x *ReadMemoryRequest) String() string {
 func (*ReadMemoryRequest) ProtoMessage() {}
 
 func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[12]
+	mi := &file_sni_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1734,7 @@ func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
 func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{12}
+	return file_sni_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
@@ -1045,6 +1765,13 @@ func (x *ReadMemoryRequest) GetSize() uint32 {
 	return 0
 }
 
+func (x *ReadMemoryRequest) GetRequestLabel() string {
+	if x != nil {
+		return x.RequestLabel
+	}
+	return ""
+}
+
 type ReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1062,7 +1789,7 @@ type ReadMemoryResponse struct {
 func (x *ReadMemoryResponse) Reset() {
 	*x = ReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[13]
+		mi := &file_sni_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1075,7 +1802,7 @@ func (x *ReadMemoryResponse) String() string {
 func (*ReadMemoryResponse) ProtoMessage() {}
 
 func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[13]
+	mi := &file_sni_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,7 +1815,7 @@ func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
 func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{13}
+	return file_sni_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
@@ -1142,12 +1869,19 @@ type WriteMemoryRequest struct {
 	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
 	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
 	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// optional expected current contents of memory; if set, `data` is only written if the current contents of memory
+	// at the request address match these bytes. must be the same length as `data`.
+	Expected []byte `protobuf:"bytes,5,opt,name=expected,proto3,oneof" json:"expected,omitempty"`
+	// optional label expression, e.g. "Link_Health+2", resolved with the symbols loaded for the device or its ROM; if
+	// set, `requestAddress` and `requestAddressSpace` are ignored and the label's SnesABus address is written instead.
+	// `requestMemoryMapping` is detected if Unknown.
+	RequestLabel string `protobuf:"bytes,6,opt,name=requestLabel,proto3" json:"requestLabel,omitempty"`
 }
 
 func (x *WriteMemoryRequest) Reset() {
 	*x = WriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[14]
+		mi := &file_sni_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1160,7 +1894,7 @@ func (x *WriteMemoryRequest) String() string {
 func (*WriteMemoryRequest) ProtoMessage() {}
 
 func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[14]
+	mi := &file_sni_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1907,7 @@ func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
 func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{14}
+	return file_sni_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
@@ -1204,6 +1938,20 @@ func (x *WriteMemoryRequest) GetData() []byte {
 	return nil
 }
 
+func (x *WriteMemoryRequest) GetExpected() []byte {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *WriteMemoryRequest) GetRequestLabel() string {
+	if x != nil {
+		return x.RequestLabel
+	}
+	return ""
+}
+
 type WriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1215,12 +1963,15 @@ type WriteMemoryResponse struct {
 	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
 	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
 	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// true if `expected` was set in the request and the current contents of memory did not match it; nothing was
+	// written and `size` is 0.
+	CompareFailed bool `protobuf:"varint,7,opt,name=compareFailed,proto3" json:"compareFailed,omitempty"`
 }
 
 func (x *WriteMemoryResponse) Reset() {
 	*x = WriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[15]
+		mi := &file_sni_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1233,7 +1984,7 @@ func (x *WriteMemoryResponse) String() string {
 func (*WriteMemoryResponse) ProtoMessage() {}
 
 func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[15]
+	mi := &file_sni_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1997,7 @@ func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
 func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{15}
+	return file_sni_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
@@ -1291,6 +2042,13 @@ func (x *WriteMemoryResponse) GetSize() uint32 {
 	return 0
 }
 
+func (x *WriteMemoryResponse) GetCompareFailed() bool {
+	if x != nil {
+		return x.CompareFailed
+	}
+	return false
+}
+
 type SingleReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1303,7 +2061,7 @@ type SingleReadMemoryRequest struct {
 func (x *SingleReadMemoryRequest) Reset() {
 	*x = SingleReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[16]
+		mi := &file_sni_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1316,7 +2074,7 @@ func (x *SingleReadMemoryRequest) String() string {
 func (*SingleReadMemoryRequest) ProtoMessage() {}
 
 func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[16]
+	mi := &file_sni_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,7 +2087,7 @@ func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
 func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{16}
+	return file_sni_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SingleReadMemoryRequest) GetUri() string {
@@ -1358,7 +2116,7 @@ type SingleReadMemoryResponse struct {
 func (x *SingleReadMemoryResponse) Reset() {
 	*x = SingleReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[17]
+		mi := &file_sni_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1371,7 +2129,7 @@ func (x *SingleReadMemoryResponse) String() string {
 func (*SingleReadMemoryResponse) ProtoMessage() {}
 
 func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[17]
+	mi := &file_sni_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +2142,7 @@ func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
 func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{17}
+	return file_sni_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SingleReadMemoryResponse) GetUri() string {
@@ -1413,7 +2171,7 @@ type SingleWriteMemoryRequest struct {
 func (x *SingleWriteMemoryRequest) Reset() {
 	*x = SingleWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[18]
+		mi := &file_sni_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1426,7 +2184,7 @@ func (x *SingleWriteMemoryRequest) String() string {
 func (*SingleWriteMemoryRequest) ProtoMessage() {}
 
 func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[18]
+	mi := &file_sni_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +2197,7 @@ func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
 func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{18}
+	return file_sni_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SingleWriteMemoryRequest) GetUri() string {
@@ -1468,7 +2226,7 @@ type SingleWriteMemoryResponse struct {
 func (x *SingleWriteMemoryResponse) Reset() {
 	*x = SingleWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[19]
+		mi := &file_sni_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1481,7 +2239,7 @@ func (x *SingleWriteMemoryResponse) String() string {
 func (*SingleWriteMemoryResponse) ProtoMessage() {}
 
 func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[19]
+	mi := &file_sni_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,7 +2252,7 @@ func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
 func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{19}
+	return file_sni_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SingleWriteMemoryResponse) GetUri() string {
@@ -1523,7 +2281,7 @@ type MultiReadMemoryRequest struct {
 func (x *MultiReadMemoryRequest) Reset() {
 	*x = MultiReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[20]
+		mi := &file_sni_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1536,7 +2294,7 @@ func (x *MultiReadMemoryRequest) String() string {
 func (*MultiReadMemoryRequest) ProtoMessage() {}
 
 func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[20]
+	mi := &file_sni_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +2307,7 @@ func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
 func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{20}
+	return file_sni_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MultiReadMemoryRequest) GetUri() string {
@@ -1578,7 +2336,7 @@ type MultiReadMemoryResponse struct {
 func (x *MultiReadMemoryResponse) Reset() {
 	*x = MultiReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[21]
+		mi := &file_sni_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1591,7 +2349,7 @@ func (x *MultiReadMemoryResponse) String() string {
 func (*MultiReadMemoryResponse) ProtoMessage() {}
 
 func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[21]
+	mi := &file_sni_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +2362,7 @@ func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
 func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{21}
+	return file_sni_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MultiReadMemoryResponse) GetUri() string {
@@ -1628,12 +2386,15 @@ type MultiWriteMemoryRequest struct {
 
 	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// if true, read back all written memory after the writes complete and fail with DATA_LOSS and a
+	// WriteVerificationFailure status detail if any of it does not match what was written
+	Verify bool `protobuf:"varint,3,opt,name=verify,proto3" json:"verify,omitempty"`
 }
 
 func (x *MultiWriteMemoryRequest) Reset() {
 	*x = MultiWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[22]
+		mi := &file_sni_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1646,7 +2407,7 @@ func (x *MultiWriteMemoryRequest) String() string {
 func (*MultiWriteMemoryRequest) ProtoMessage() {}
 
 func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[22]
+	mi := &file_sni_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2420,7 @@ func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
 func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{22}
+	return file_sni_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MultiWriteMemoryRequest) GetUri() string {
@@ -1676,6 +2437,13 @@ func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
 	return nil
 }
 
+func (x *MultiWriteMemoryRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
 type MultiWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1688,7 +2456,7 @@ type MultiWriteMemoryResponse struct {
 func (x *MultiWriteMemoryResponse) Reset() {
 	*x = MultiWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[23]
+		mi := &file_sni_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1701,7 +2469,7 @@ func (x *MultiWriteMemoryResponse) String() string {
 func (*MultiWriteMemoryResponse) ProtoMessage() {}
 
 func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[23]
+	mi := &file_sni_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2482,7 @@ func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
 func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{23}
+	return file_sni_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MultiWriteMemoryResponse) GetUri() string {
@@ -1731,32 +2499,35 @@ func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
 	return nil
 }
 
-type ReadDirectoryRequest struct {
+// status error detail of a MultiWrite with `verify` set whose written memory did not read back as written
+type WriteVerificationFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// responses to all the writes, as they would have been returned without verification
+	Responses  []*WriteMemoryResponse               `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Mismatches []*WriteVerificationFailure_Mismatch `protobuf:"bytes,3,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
 }
 
-func (x *ReadDirectoryRequest) Reset() {
-	*x = ReadDirectoryRequest{}
+func (x *WriteVerificationFailure) Reset() {
+	*x = WriteVerificationFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[24]
+		mi := &file_sni_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirectoryRequest) String() string {
+func (x *WriteVerificationFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryRequest) ProtoMessage() {}
+func (*WriteVerificationFailure) ProtoMessage() {}
 
-func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[24]
+func (x *WriteVerificationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,51 +2538,61 @@ func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use WriteVerificationFailure.ProtoReflect.Descriptor instead.
+func (*WriteVerificationFailure) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReadDirectoryRequest) GetUri() string {
+func (x *WriteVerificationFailure) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *ReadDirectoryRequest) GetPath() string {
+func (x *WriteVerificationFailure) GetResponses() []*WriteMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-type DirEntry struct {
+func (x *WriteVerificationFailure) GetMismatches() []*WriteVerificationFailure_Mismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+type WatchMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// memory segments to watch for changes:
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// minimum interval between polls of the device in milliseconds; 0 polls once every frame
+	PollIntervalMilliseconds uint32 `protobuf:"varint,3,opt,name=pollIntervalMilliseconds,proto3" json:"pollIntervalMilliseconds,omitempty"`
 }
 
-func (x *DirEntry) Reset() {
-	*x = DirEntry{}
+func (x *WatchMemoryRequest) Reset() {
+	*x = WatchMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[25]
+		mi := &file_sni_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DirEntry) String() string {
+func (x *WatchMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirEntry) ProtoMessage() {}
+func (*WatchMemoryRequest) ProtoMessage() {}
 
-func (x *DirEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[25]
+func (x *WatchMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,52 +2603,62 @@ func (x *DirEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
-func (*DirEntry) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WatchMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DirEntry) GetName() string {
+func (x *WatchMemoryRequest) GetUri() string {
 	if x != nil {
-		return x.Name
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *DirEntry) GetType() DirEntryType {
+func (x *WatchMemoryRequest) GetRequests() []*ReadMemoryRequest {
 	if x != nil {
-		return x.Type
+		return x.Requests
 	}
-	return DirEntryType_Directory
+	return nil
 }
 
-type ReadDirectoryResponse struct {
+func (x *WatchMemoryRequest) GetPollIntervalMilliseconds() uint32 {
+	if x != nil {
+		return x.PollIntervalMilliseconds
+	}
+	return 0
+}
+
+type WatchMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// current contents of all watched memory segments, in the same order as `requests` from WatchMemoryRequest
+	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	// indexes into `responses` of the segments whose contents changed since the previous response;
+	// the first response lists all indexes
+	Changed []uint32 `protobuf:"varint,3,rep,packed,name=changed,proto3" json:"changed,omitempty"`
 }
 
-func (x *ReadDirectoryResponse) Reset() {
-	*x = ReadDirectoryResponse{}
+func (x *WatchMemoryResponse) Reset() {
+	*x = WatchMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[26]
+		mi := &file_sni_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirectoryResponse) String() string {
+func (x *WatchMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryResponse) ProtoMessage() {}
+func (*WatchMemoryResponse) ProtoMessage() {}
 
-func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[26]
+func (x *WatchMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,58 +2669,63 @@ func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use WatchMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WatchMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ReadDirectoryResponse) GetUri() string {
+func (x *WatchMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *ReadDirectoryResponse) GetPath() string {
+func (x *WatchMemoryResponse) GetResponses() []*ReadMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+func (x *WatchMemoryResponse) GetChanged() []uint32 {
 	if x != nil {
-		return x.Entries
+		return x.Changed
 	}
 	return nil
 }
 
-type MakeDirectoryRequest struct {
+type ReadTypedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// name of a schema file "<schema>.yaml" in the "schemas" directory of the SNI config directory
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// YAML schema to use instead of a schema file
+	SchemaYaml []byte `protobuf:"bytes,3,opt,name=schemaYaml,proto3" json:"schemaYaml,omitempty"`
+	// names of the fields to read; all fields are read if empty
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func (x *MakeDirectoryRequest) Reset() {
-	*x = MakeDirectoryRequest{}
+func (x *ReadTypedRequest) Reset() {
+	*x = ReadTypedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[27]
+		mi := &file_sni_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakeDirectoryRequest) String() string {
+func (x *ReadTypedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryRequest) ProtoMessage() {}
+func (*ReadTypedRequest) ProtoMessage() {}
 
-func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[27]
+func (x *ReadTypedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,51 +2736,67 @@ func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ReadTypedRequest.ProtoReflect.Descriptor instead.
+func (*ReadTypedRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MakeDirectoryRequest) GetUri() string {
+func (x *ReadTypedRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MakeDirectoryRequest) GetPath() string {
+func (x *ReadTypedRequest) GetSchema() string {
 	if x != nil {
-		return x.Path
+		return x.Schema
 	}
 	return ""
 }
 
-type MakeDirectoryResponse struct {
+func (x *ReadTypedRequest) GetSchemaYaml() []byte {
+	if x != nil {
+		return x.SchemaYaml
+	}
+	return nil
+}
+
+func (x *ReadTypedRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ReadTypedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// decoded values by field name
+	Values map[string]*TypedValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MakeDirectoryResponse) Reset() {
-	*x = MakeDirectoryResponse{}
+func (x *ReadTypedResponse) Reset() {
+	*x = ReadTypedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[28]
+		mi := &file_sni_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakeDirectoryResponse) String() string {
+func (x *ReadTypedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryResponse) ProtoMessage() {}
+func (*ReadTypedResponse) ProtoMessage() {}
 
-func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[28]
+func (x *ReadTypedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,51 +2807,63 @@ func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ReadTypedResponse.ProtoReflect.Descriptor instead.
+func (*ReadTypedResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MakeDirectoryResponse) GetUri() string {
+func (x *ReadTypedResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MakeDirectoryResponse) GetPath() string {
+func (x *ReadTypedResponse) GetSchema() string {
 	if x != nil {
-		return x.Path
+		return x.Schema
 	}
 	return ""
 }
 
-type RemoveFileRequest struct {
+func (x *ReadTypedResponse) GetValues() map[string]*TypedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type TypedValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Types that are assignable to Value:
+	//	*TypedValue_Uint
+	//	*TypedValue_Int
+	//	*TypedValue_Bits_
+	//	*TypedValue_Bytes
+	//	*TypedValue_Array_
+	Value isTypedValue_Value `protobuf_oneof:"value"`
 }
 
-func (x *RemoveFileRequest) Reset() {
-	*x = RemoveFileRequest{}
+func (x *TypedValue) Reset() {
+	*x = TypedValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[29]
+		mi := &file_sni_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveFileRequest) String() string {
+func (x *TypedValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileRequest) ProtoMessage() {}
+func (*TypedValue) ProtoMessage() {}
 
-func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[29]
+func (x *TypedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,107 +2874,123 @@ func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TypedValue.ProtoReflect.Descriptor instead.
+func (*TypedValue) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RemoveFileRequest) GetUri() string {
-	if x != nil {
-		return x.Uri
+func (m *TypedValue) GetValue() isTypedValue_Value {
+	if m != nil {
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveFileRequest) GetPath() string {
-	if x != nil {
-		return x.Path
+func (x *TypedValue) GetUint() uint64 {
+	if x, ok := x.GetValue().(*TypedValue_Uint); ok {
+		return x.Uint
 	}
-	return ""
+	return 0
 }
 
-type RemoveFileResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+func (x *TypedValue) GetInt() int64 {
+	if x, ok := x.GetValue().(*TypedValue_Int); ok {
+		return x.Int
+	}
+	return 0
 }
 
-func (x *RemoveFileResponse) Reset() {
-	*x = RemoveFileResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TypedValue) GetBits() *TypedValue_Bits {
+	if x, ok := x.GetValue().(*TypedValue_Bits_); ok {
+		return x.Bits
 	}
+	return nil
 }
 
-func (x *RemoveFileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TypedValue) GetBytes() []byte {
+	if x, ok := x.GetValue().(*TypedValue_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
 }
 
-func (*RemoveFileResponse) ProtoMessage() {}
-
-func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TypedValue) GetArray() *TypedValue_Array {
+	if x, ok := x.GetValue().(*TypedValue_Array_); ok {
+		return x.Array
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{30}
+type isTypedValue_Value interface {
+	isTypedValue_Value()
 }
 
-func (x *RemoveFileResponse) GetUri() string {
-	if x != nil {
-		return x.Uri
-	}
-	return ""
+type TypedValue_Uint struct {
+	// u8, u16le, u24le, u32le, bcd8 and bcd16 fields
+	Uint uint64 `protobuf:"varint,1,opt,name=uint,proto3,oneof"`
 }
 
-func (x *RemoveFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type TypedValue_Int struct {
+	// s8 and s16le fields
+	Int int64 `protobuf:"zigzag64,2,opt,name=int,proto3,oneof"`
 }
 
-type RenameFileRequest struct {
+type TypedValue_Bits_ struct {
+	// bitfield fields
+	Bits *TypedValue_Bits `protobuf:"bytes,3,opt,name=bits,proto3,oneof"`
+}
+
+type TypedValue_Bytes struct {
+	// bytes fields
+	Bytes []byte `protobuf:"bytes,4,opt,name=bytes,proto3,oneof"`
+}
+
+type TypedValue_Array_ struct {
+	// fields with a count
+	Array *TypedValue_Array `protobuf:"bytes,5,opt,name=array,proto3,oneof"`
+}
+
+func (*TypedValue_Uint) isTypedValue_Value() {}
+
+func (*TypedValue_Int) isTypedValue_Value() {}
+
+func (*TypedValue_Bits_) isTypedValue_Value() {}
+
+func (*TypedValue_Bytes) isTypedValue_Value() {}
+
+func (*TypedValue_Array_) isTypedValue_Value() {}
+
+type AcquireLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// duration of the lease in milliseconds; must be greater than 0 and at most 300000 (5 minutes)
+	DurationMilliseconds uint32 `protobuf:"varint,2,opt,name=durationMilliseconds,proto3" json:"durationMilliseconds,omitempty"`
+	// token of a lease currently held to renew it for another `durationMilliseconds`
+	Token *string `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	// if true, wait for a lease held by another client to end instead of failing immediately with UNAVAILABLE
+	Wait bool `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`
 }
 
-func (x *RenameFileRequest) Reset() {
-	*x = RenameFileRequest{}
+func (x *AcquireLeaseRequest) Reset() {
+	*x = AcquireLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[31]
+		mi := &file_sni_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileRequest) String() string {
+func (x *AcquireLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileRequest) ProtoMessage() {}
+func (*AcquireLeaseRequest) ProtoMessage() {}
 
-func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[31]
+func (x *AcquireLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,59 +3001,68 @@ func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
-func (*RenameFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AcquireLeaseRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RenameFileRequest) GetUri() string {
+func (x *AcquireLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileRequest) GetPath() string {
+func (x *AcquireLeaseRequest) GetDurationMilliseconds() uint32 {
 	if x != nil {
-		return x.Path
+		return x.DurationMilliseconds
+	}
+	return 0
+}
+
+func (x *AcquireLeaseRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
 	return ""
 }
 
-func (x *RenameFileRequest) GetNewFilename() string {
+func (x *AcquireLeaseRequest) GetWait() bool {
 	if x != nil {
-		return x.NewFilename
+		return x.Wait
 	}
-	return ""
+	return false
 }
 
-type RenameFileResponse struct {
+type AcquireLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// token identifying the lease; send it in the `sni-lease-token` request metadata to use the device
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// time the lease expires at in milliseconds since the unix epoch
+	ExpiresAtUnixMilliseconds int64 `protobuf:"varint,3,opt,name=expiresAtUnixMilliseconds,proto3" json:"expiresAtUnixMilliseconds,omitempty"`
 }
 
-func (x *RenameFileResponse) Reset() {
-	*x = RenameFileResponse{}
+func (x *AcquireLeaseResponse) Reset() {
+	*x = AcquireLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[32]
+		mi := &file_sni_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileResponse) String() string {
+func (x *AcquireLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileResponse) ProtoMessage() {}
+func (*AcquireLeaseResponse) ProtoMessage() {}
 
-func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[32]
+func (x *AcquireLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,59 +3073,58 @@ func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
-func (*RenameFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AcquireLeaseResponse.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RenameFileResponse) GetUri() string {
+func (x *AcquireLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileResponse) GetPath() string {
+func (x *AcquireLeaseResponse) GetToken() string {
 	if x != nil {
-		return x.Path
+		return x.Token
 	}
 	return ""
 }
 
-func (x *RenameFileResponse) GetNewFilename() string {
+func (x *AcquireLeaseResponse) GetExpiresAtUnixMilliseconds() int64 {
 	if x != nil {
-		return x.NewFilename
+		return x.ExpiresAtUnixMilliseconds
 	}
-	return ""
+	return 0
 }
 
-type PutFileRequest struct {
+type ReleaseLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri   string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *PutFileRequest) Reset() {
-	*x = PutFileRequest{}
+func (x *ReleaseLeaseRequest) Reset() {
+	*x = ReleaseLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[33]
+		mi := &file_sni_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutFileRequest) String() string {
+func (x *ReleaseLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileRequest) ProtoMessage() {}
+func (*ReleaseLeaseRequest) ProtoMessage() {}
 
-func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[33]
+func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,59 +3135,50 @@ func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
-func (*PutFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *PutFileRequest) GetUri() string {
+func (x *ReleaseLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileRequest) GetPath() string {
+func (x *ReleaseLeaseRequest) GetToken() string {
 	if x != nil {
-		return x.Path
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PutFileRequest) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type PutFileResponse struct {
+type ReleaseLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *PutFileResponse) Reset() {
-	*x = PutFileResponse{}
+func (x *ReleaseLeaseResponse) Reset() {
+	*x = ReleaseLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[34]
+		mi := &file_sni_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutFileResponse) String() string {
+func (x *ReleaseLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileResponse) ProtoMessage() {}
+func (*ReleaseLeaseResponse) ProtoMessage() {}
 
-func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[34]
+func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2350,58 +3189,47 @@ func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
-func (*PutFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *PutFileResponse) GetUri() string {
+func (x *ReleaseLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *PutFileResponse) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-type GetFileRequest struct {
+// a memory region captured in a snapshot; one of WRAM, SRAM, VRAM, APU, CGRAM or OAM
+type SnapshotRegion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// start address of the region in the FxPakPro address space
+	Address uint32 `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"`
+	Size    uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *SnapshotRegion) Reset() {
+	*x = SnapshotRegion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[35]
+		mi := &file_sni_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *SnapshotRegion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*SnapshotRegion) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[35]
+func (x *SnapshotRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,53 +3240,65 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SnapshotRegion.ProtoReflect.Descriptor instead.
+func (*SnapshotRegion) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetFileRequest) GetUri() string {
+func (x *SnapshotRegion) GetName() string {
 	if x != nil {
-		return x.Uri
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetFileRequest) GetPath() string {
+func (x *SnapshotRegion) GetAddress() uint32 {
 	if x != nil {
-		return x.Path
+		return x.Address
 	}
-	return ""
+	return 0
 }
 
-type GetFileResponse struct {
+func (x *SnapshotRegion) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SnapshotInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// uri of the device the snapshot was taken from
+	Uri                       string        `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	CreatedAtUnixMilliseconds int64         `protobuf:"varint,3,opt,name=createdAtUnixMilliseconds,proto3" json:"createdAtUnixMilliseconds,omitempty"`
+	MemoryMapping             MemoryMapping `protobuf:"varint,4,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// title and checksum from the ROM header of the game the snapshot was taken from
+	RomTitle    string            `protobuf:"bytes,5,opt,name=romTitle,proto3" json:"romTitle,omitempty"`
+	RomChecksum uint32            `protobuf:"varint,6,opt,name=romChecksum,proto3" json:"romChecksum,omitempty"`
+	Regions     []*SnapshotRegion `protobuf:"bytes,7,rep,name=regions,proto3" json:"regions,omitempty"`
 }
 
-func (x *GetFileResponse) Reset() {
-	*x = GetFileResponse{}
+func (x *SnapshotInfo) Reset() {
+	*x = SnapshotInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[36]
+		mi := &file_sni_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetFileResponse) String() string {
+func (x *SnapshotInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileResponse) ProtoMessage() {}
+func (*SnapshotInfo) ProtoMessage() {}
 
-func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[36]
+func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,65 +3309,89 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
-func (*GetFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetFileResponse) GetUri() string {
+func (x *SnapshotInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotInfo) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *GetFileResponse) GetPath() string {
+func (x *SnapshotInfo) GetCreatedAtUnixMilliseconds() int64 {
 	if x != nil {
-		return x.Path
+		return x.CreatedAtUnixMilliseconds
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *SnapshotInfo) GetRomTitle() string {
+	if x != nil {
+		return x.RomTitle
 	}
 	return ""
 }
 
-func (x *GetFileResponse) GetSize() uint32 {
+func (x *SnapshotInfo) GetRomChecksum() uint32 {
 	if x != nil {
-		return x.Size
+		return x.RomChecksum
 	}
 	return 0
 }
 
-func (x *GetFileResponse) GetData() []byte {
+func (x *SnapshotInfo) GetRegions() []*SnapshotRegion {
 	if x != nil {
-		return x.Data
+		return x.Regions
 	}
 	return nil
 }
 
-type BootFileRequest struct {
+type TakeSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// name to store the snapshot as, replacing any snapshot with the same name; generated from the time if empty
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// names of regions to capture; WRAM and SRAM if empty. SRAM is skipped if the cartridge has none
+	Regions []string `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
 }
 
-func (x *BootFileRequest) Reset() {
-	*x = BootFileRequest{}
+func (x *TakeSnapshotRequest) Reset() {
+	*x = TakeSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[37]
+		mi := &file_sni_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BootFileRequest) String() string {
+func (x *TakeSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileRequest) ProtoMessage() {}
+func (*TakeSnapshotRequest) ProtoMessage() {}
 
-func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[37]
+func (x *TakeSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,51 +3402,58 @@ func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
-func (*BootFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TakeSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*TakeSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *BootFileRequest) GetUri() string {
+func (x *TakeSnapshotRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileRequest) GetPath() string {
+func (x *TakeSnapshotRequest) GetName() string {
 	if x != nil {
-		return x.Path
+		return x.Name
 	}
 	return ""
 }
 
-type BootFileResponse struct {
+func (x *TakeSnapshotRequest) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type TakeSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Snapshot *SnapshotInfo `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *BootFileResponse) Reset() {
-	*x = BootFileResponse{}
+func (x *TakeSnapshotResponse) Reset() {
+	*x = TakeSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[38]
+		mi := &file_sni_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BootFileResponse) String() string {
+func (x *TakeSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileResponse) ProtoMessage() {}
+func (*TakeSnapshotResponse) ProtoMessage() {}
 
-func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[38]
+func (x *TakeSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,51 +3464,55 @@ func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
-func (*BootFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use TakeSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*TakeSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *BootFileResponse) GetUri() string {
+func (x *TakeSnapshotResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileResponse) GetPath() string {
+func (x *TakeSnapshotResponse) GetSnapshot() *SnapshotInfo {
 	if x != nil {
-		return x.Path
+		return x.Snapshot
 	}
-	return ""
+	return nil
 }
 
-type FieldsRequest struct {
+type RestoreSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// names of regions to restore; all regions of the snapshot if empty. naming a read-only region is an error
+	Regions []string `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+	// restore even if the snapshot was taken from a different game than the one currently running
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *FieldsRequest) Reset() {
-	*x = FieldsRequest{}
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[39]
+		mi := &file_sni_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldsRequest) String() string {
+func (x *RestoreSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsRequest) ProtoMessage() {}
+func (*RestoreSnapshotRequest) ProtoMessage() {}
 
-func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[39]
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,52 +3523,69 @@ func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
-func (*FieldsRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *FieldsRequest) GetUri() string {
+func (x *RestoreSnapshotRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *FieldsRequest) GetFields() []Field {
+func (x *RestoreSnapshotRequest) GetName() string {
 	if x != nil {
-		return x.Fields
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetRegions() []string {
+	if x != nil {
+		return x.Regions
 	}
 	return nil
 }
 
-type FieldsResponse struct {
+func (x *RestoreSnapshotRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RestoreSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
-	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Snapshot *SnapshotInfo `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// regions written to the device
+	Restored []*SnapshotRegion `protobuf:"bytes,3,rep,name=restored,proto3" json:"restored,omitempty"`
+	// read-only regions of the snapshot that were not written to the device, e.g. VRAM
+	Skipped []*SnapshotRegion `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
 }
 
-func (x *FieldsResponse) Reset() {
-	*x = FieldsResponse{}
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[40]
+		mi := &file_sni_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldsResponse) String() string {
+func (x *RestoreSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsResponse) ProtoMessage() {}
+func (*RestoreSnapshotResponse) ProtoMessage() {}
 
-func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[40]
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,63 +3596,62 @@ func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
-func (*FieldsResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *FieldsResponse) GetUri() string {
+func (x *RestoreSnapshotResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *FieldsResponse) GetFields() []Field {
+func (x *RestoreSnapshotResponse) GetSnapshot() *SnapshotInfo {
 	if x != nil {
-		return x.Fields
+		return x.Snapshot
 	}
 	return nil
 }
 
-func (x *FieldsResponse) GetValues() []string {
+func (x *RestoreSnapshotResponse) GetRestored() []*SnapshotRegion {
 	if x != nil {
-		return x.Values
+		return x.Restored
 	}
 	return nil
 }
 
-type NWACommandRequest struct {
+func (x *RestoreSnapshotResponse) GetSkipped() []*SnapshotRegion {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// emu-nwaccess command name:
-	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	// command arguments:
-	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
-	// an optional binary argument:
-	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
 }
 
-func (x *NWACommandRequest) Reset() {
-	*x = NWACommandRequest{}
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[41]
+		mi := &file_sni_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NWACommandRequest) String() string {
+func (x *ListSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandRequest) ProtoMessage() {}
+func (*ListSnapshotsRequest) ProtoMessage() {}
 
-func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[41]
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,66 +3662,91 @@ func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
-func (*NWACommandRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *NWACommandRequest) GetUri() string {
-	if x != nil {
-		return x.Uri
-	}
-	return ""
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*SnapshotInfo `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (x *NWACommandRequest) GetCommand() string {
-	if x != nil {
-		return x.Command
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *NWACommandRequest) GetArgs() string {
-	if x != nil {
-		return x.Args
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NWACommandRequest) GetBinaryArg() []byte {
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
 	if x != nil {
-		return x.BinaryArg
+		return x.Snapshots
 	}
 	return nil
 }
 
-type NWACommandResponse struct {
+type DiffSnapshotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
-	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+	// name of the snapshot to compare from
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// name of the snapshot to compare to; if empty, the current memory of the device at `uri` is compared to instead
+	OtherName string `protobuf:"bytes,2,opt,name=otherName,proto3" json:"otherName,omitempty"`
+	Uri       string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	// names of regions to compare; all regions present in both if empty
+	Regions []string `protobuf:"bytes,4,rep,name=regions,proto3" json:"regions,omitempty"`
+	// runs of changed bytes separated by fewer than this many unchanged bytes are reported as one change; defaults to 1
+	MergeGap uint32 `protobuf:"varint,5,opt,name=mergeGap,proto3" json:"mergeGap,omitempty"`
 }
 
-func (x *NWACommandResponse) Reset() {
-	*x = NWACommandResponse{}
+func (x *DiffSnapshotsRequest) Reset() {
+	*x = DiffSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[42]
+		mi := &file_sni_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NWACommandResponse) String() string {
+func (x *DiffSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse) ProtoMessage() {}
+func (*DiffSnapshotsRequest) ProtoMessage() {}
 
-func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[42]
+func (x *DiffSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2841,76 +3757,126 @@ func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DiffSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*DiffSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *NWACommandResponse) GetUri() string {
+func (x *DiffSnapshotsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffSnapshotsRequest) GetOtherName() string {
+	if x != nil {
+		return x.OtherName
+	}
+	return ""
+}
+
+func (x *DiffSnapshotsRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
+func (x *DiffSnapshotsRequest) GetRegions() []string {
 	if x != nil {
-		return x.AsciiReply
+		return x.Regions
 	}
 	return nil
 }
 
-func (x *NWACommandResponse) GetBinaryReplay() []byte {
+func (x *DiffSnapshotsRequest) GetMergeGap() uint32 {
 	if x != nil {
-		return x.BinaryReplay
+		return x.MergeGap
+	}
+	return 0
+}
+
+type DiffSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regions []*DiffSnapshotsResponse_RegionDiff `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *DiffSnapshotsResponse) Reset() {
+	*x = DiffSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSnapshotsResponse) ProtoMessage() {}
+
+func (x *DiffSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DiffSnapshotsResponse) GetRegions() []*DiffSnapshotsResponse_RegionDiff {
+	if x != nil {
+		return x.Regions
 	}
 	return nil
 }
 
-type DevicesResponse_Device struct {
+type SramBackup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URI that describes exactly how to connect to the device, e.g.:
-	// RetroArch:  "ra://127.0.0.1:55355"
-	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
-	//             "fxpakpro://./COM4"                         (Windows)
-	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
-	// uri is used as the unique identifier of the device for clients to refer to
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// friendly display name of the device
-	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	// device kind, e.g. "fxpakpro", "retroarch", "lua"
-	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	// all device capabilities:
-	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
-	// default address space for the device:
-	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
-	// [DEPRECATED] console system supported, e.g. "snes", "n64"
-	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
-	//
-	// Deprecated: Do not use.
-	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+	// key identifying the game the backup was taken from, made of its ROM title and checksum, e.g. `SUPER_METROID-f8df`
+	Rom string `protobuf:"bytes,1,opt,name=rom,proto3" json:"rom,omitempty"`
+	// title and checksum from the ROM header of the game
+	RomTitle    string `protobuf:"bytes,2,opt,name=romTitle,proto3" json:"romTitle,omitempty"`
+	RomChecksum uint32 `protobuf:"varint,3,opt,name=romChecksum,proto3" json:"romChecksum,omitempty"`
+	// id of the backup, made of the time it was taken and a hash of its contents
+	Id                        string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAtUnixMilliseconds int64  `protobuf:"varint,5,opt,name=createdAtUnixMilliseconds,proto3" json:"createdAtUnixMilliseconds,omitempty"`
+	Size                      uint32 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *DevicesResponse_Device) Reset() {
-	*x = DevicesResponse_Device{}
+func (x *SramBackup) Reset() {
+	*x = SramBackup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[43]
+		mi := &file_sni_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DevicesResponse_Device) String() string {
+func (x *SramBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DevicesResponse_Device) ProtoMessage() {}
+func (*SramBackup) ProtoMessage() {}
 
-func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[43]
+func (x *SramBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2921,79 +3887,81 @@ func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
-func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use SramBackup.ProtoReflect.Descriptor instead.
+func (*SramBackup) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DevicesResponse_Device) GetUri() string {
+func (x *SramBackup) GetRom() string {
 	if x != nil {
-		return x.Uri
+		return x.Rom
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetDisplayName() string {
+func (x *SramBackup) GetRomTitle() string {
 	if x != nil {
-		return x.DisplayName
+		return x.RomTitle
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetKind() string {
+func (x *SramBackup) GetRomChecksum() uint32 {
 	if x != nil {
-		return x.Kind
+		return x.RomChecksum
 	}
-	return ""
+	return 0
 }
 
-func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+func (x *SramBackup) GetId() string {
 	if x != nil {
-		return x.Capabilities
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+func (x *SramBackup) GetCreatedAtUnixMilliseconds() int64 {
 	if x != nil {
-		return x.DefaultAddressSpace
+		return x.CreatedAtUnixMilliseconds
 	}
-	return AddressSpace_FxPakPro
+	return 0
 }
 
-// Deprecated: Do not use.
-func (x *DevicesResponse_Device) GetSystem() string {
+func (x *SramBackup) GetSize() uint32 {
 	if x != nil {
-		return x.System
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type NWACommandResponse_NWAASCIIItem struct {
+type ListSramBackupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// only list backups of this game; see SramBackup.rom
+	Rom string `protobuf:"bytes,1,opt,name=rom,proto3" json:"rom,omitempty"`
+	// if set and `rom` is empty, only list backups of the game currently running on this device
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) Reset() {
-	*x = NWACommandResponse_NWAASCIIItem{}
+func (x *ListSramBackupsRequest) Reset() {
+	*x = ListSramBackupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[44]
+		mi := &file_sni_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) String() string {
+func (x *ListSramBackupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+func (*ListSramBackupsRequest) ProtoMessage() {}
 
-func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[44]
+func (x *ListSramBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3004,602 +3972,5327 @@ func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{42, 0}
+// Deprecated: Use ListSramBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListSramBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
+func (x *ListSramBackupsRequest) GetRom() string {
 	if x != nil {
-		return x.Item
+		return x.Rom
 	}
-	return nil
+	return ""
 }
 
-var File_sni_proto protoreflect.FileDescriptor
+func (x *ListSramBackupsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
 
-var file_sni_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
-	0x6e, 0x64, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xe4, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xd6, 0x01,
-	0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x49,
-	0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
-	0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x6f, 0x6d,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
-	0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x66, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42,
-	0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
-	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x01, 0x0a,
-	0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a,
-	0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x18,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d,
-	0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b,
-	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12,
-	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x11, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x41, 0x72, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x41, 0x72, 0x67, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40,
-	0x0a, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x27, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74,
-	0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x79, 0x2a, 0x33, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01,
-	0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0d, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x41,
-	0x31, 0x10, 0x04, 0x2a, 0xb3, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53,
-	0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12,
-	0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x0a, 0x12, 0x11,
-	0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10,
-	0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0e, 0x12, 0x0b,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x42,
-	0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x57, 0x41,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x2a, 0xa1, 0x01, 0x0a, 0x05, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6f, 0x72, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x72, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f,
-	0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x10, 0x29, 0x12, 0x10, 0x0a, 0x0c, 0x52,
-	0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x2a, 0x2a, 0x27, 0x0a,
-	0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x3d, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xaa, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65,
-	0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
-	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x32, 0x81, 0x04, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12,
-	0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15,
-	0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50,
-	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x57,
-	0x41, 0x12, 0x37, 0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x12, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x0a, 0x15, 0x63, 0x6f,
-	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2e,
-	0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+type ListSramBackupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backups []*SramBackup `protobuf:"bytes,1,rep,name=backups,proto3" json:"backups,omitempty"`
 }
 
-var (
-	file_sni_proto_rawDescOnce sync.Once
-	file_sni_proto_rawDescData = file_sni_proto_rawDesc
-)
+func (x *ListSramBackupsResponse) Reset() {
+	*x = ListSramBackupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_sni_proto_rawDescGZIP() []byte {
-	file_sni_proto_rawDescOnce.Do(func() {
-		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
-	})
-	return file_sni_proto_rawDescData
+func (x *ListSramBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_sni_proto_goTypes = []interface{}{
-	(AddressSpace)(0),                       // 0: AddressSpace
-	(MemoryMapping)(0),                      // 1: MemoryMapping
-	(DeviceCapability)(0),                   // 2: DeviceCapability
-	(Field)(0),                              // 3: Field
-	(DirEntryType)(0),                       // 4: DirEntryType
-	(*DevicesRequest)(nil),                  // 5: DevicesRequest
-	(*DevicesResponse)(nil),                 // 6: DevicesResponse
-	(*ResetSystemRequest)(nil),              // 7: ResetSystemRequest
-	(*ResetSystemResponse)(nil),             // 8: ResetSystemResponse
-	(*ResetToMenuRequest)(nil),              // 9: ResetToMenuRequest
-	(*ResetToMenuResponse)(nil),             // 10: ResetToMenuResponse
-	(*PauseEmulationRequest)(nil),           // 11: PauseEmulationRequest
-	(*PauseEmulationResponse)(nil),          // 12: PauseEmulationResponse
-	(*PauseToggleEmulationRequest)(nil),     // 13: PauseToggleEmulationRequest
-	(*PauseToggleEmulationResponse)(nil),    // 14: PauseToggleEmulationResponse
-	(*DetectMemoryMappingRequest)(nil),      // 15: DetectMemoryMappingRequest
-	(*DetectMemoryMappingResponse)(nil),     // 16: DetectMemoryMappingResponse
-	(*ReadMemoryRequest)(nil),               // 17: ReadMemoryRequest
-	(*ReadMemoryResponse)(nil),              // 18: ReadMemoryResponse
-	(*WriteMemoryRequest)(nil),              // 19: WriteMemoryRequest
-	(*WriteMemoryResponse)(nil),             // 20: WriteMemoryResponse
-	(*SingleReadMemoryRequest)(nil),         // 21: SingleReadMemoryRequest
-	(*SingleReadMemoryResponse)(nil),        // 22: SingleReadMemoryResponse
-	(*SingleWriteMemoryRequest)(nil),        // 23: SingleWriteMemoryRequest
-	(*SingleWriteMemoryResponse)(nil),       // 24: SingleWriteMemoryResponse
-	(*MultiReadMemoryRequest)(nil),          // 25: MultiReadMemoryRequest
-	(*MultiReadMemoryResponse)(nil),         // 26: MultiReadMemoryResponse
-	(*MultiWriteMemoryRequest)(nil),         // 27: MultiWriteMemoryRequest
-	(*MultiWriteMemoryResponse)(nil),        // 28: MultiWriteMemoryResponse
-	(*ReadDirectoryRequest)(nil),            // 29: ReadDirectoryRequest
-	(*DirEntry)(nil),                        // 30: DirEntry
-	(*ReadDirectoryResponse)(nil),           // 31: ReadDirectoryResponse
-	(*MakeDirectoryRequest)(nil),            // 32: MakeDirectoryRequest
-	(*MakeDirectoryResponse)(nil),           // 33: MakeDirectoryResponse
-	(*RemoveFileRequest)(nil),               // 34: RemoveFileRequest
-	(*RemoveFileResponse)(nil),              // 35: RemoveFileResponse
-	(*RenameFileRequest)(nil),               // 36: RenameFileRequest
-	(*RenameFileResponse)(nil),              // 37: RenameFileResponse
-	(*PutFileRequest)(nil),                  // 38: PutFileRequest
-	(*PutFileResponse)(nil),                 // 39: PutFileResponse
-	(*GetFileRequest)(nil),                  // 40: GetFileRequest
-	(*GetFileResponse)(nil),                 // 41: GetFileResponse
-	(*BootFileRequest)(nil),                 // 42: BootFileRequest
-	(*BootFileResponse)(nil),                // 43: BootFileResponse
-	(*FieldsRequest)(nil),                   // 44: FieldsRequest
-	(*FieldsResponse)(nil),                  // 45: FieldsResponse
-	(*NWACommandRequest)(nil),               // 46: NWACommandRequest
-	(*NWACommandResponse)(nil),              // 47: NWACommandResponse
-	(*DevicesResponse_Device)(nil),          // 48: DevicesResponse.Device
-	(*NWACommandResponse_NWAASCIIItem)(nil), // 49: NWACommandResponse.NWAASCIIItem
-	nil,                                     // 50: NWACommandResponse.NWAASCIIItem.ItemEntry
+func (*ListSramBackupsResponse) ProtoMessage() {}
+
+func (x *ListSramBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_sni_proto_depIdxs = []int32{
-	48, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
-	1,  // 1: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
-	1,  // 2: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
-	0,  // 3: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 4: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 5: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 6: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 7: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	0,  // 8: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 9: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 10: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 11: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 12: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	17, // 13: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
-	18, // 14: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
-	19, // 15: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
-	20, // 16: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
-	17, // 17: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
-	18, // 18: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
-	19, // 19: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
-	20, // 20: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
-	4,  // 21: DirEntry.type:type_name -> DirEntryType
-	30, // 22: ReadDirectoryResponse.entries:type_name -> DirEntry
-	3,  // 23: FieldsRequest.fields:type_name -> Field
-	3,  // 24: FieldsResponse.fields:type_name -> Field
-	49, // 25: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
-	2,  // 26: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
-	0,  // 27: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
-	50, // 28: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
-	5,  // 29: Devices.ListDevices:input_type -> DevicesRequest
-	7,  // 30: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
-	9,  // 31: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
-	11, // 32: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
-	13, // 33: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
-	15, // 34: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
-	21, // 35: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
-	23, // 36: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
-	25, // 37: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
-	27, // 38: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
-	25, // 39: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
-	27, // 40: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
-	29, // 41: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
-	32, // 42: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
-	34, // 43: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
-	36, // 44: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
-	38, // 45: DeviceFilesystem.PutFile:input_type -> PutFileRequest
-	40, // 46: DeviceFilesystem.GetFile:input_type -> GetFileRequest
-	42, // 47: DeviceFilesystem.BootFile:input_type -> BootFileRequest
-	44, // 48: DeviceInfo.FetchFields:input_type -> FieldsRequest
-	46, // 49: DeviceNWA.NWACommand:input_type -> NWACommandRequest
-	6,  // 50: Devices.ListDevices:output_type -> DevicesResponse
-	8,  // 51: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
-	10, // 52: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
-	12, // 53: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
-	14, // 54: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
-	16, // 55: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
-	22, // 56: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
-	24, // 57: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
-	26, // 58: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
-	28, // 59: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
-	26, // 60: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
-	28, // 61: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
-	31, // 62: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
-	33, // 63: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
-	35, // 64: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
-	37, // 65: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
-	39, // 66: DeviceFilesystem.PutFile:output_type -> PutFileResponse
-	41, // 67: DeviceFilesystem.GetFile:output_type -> GetFileResponse
-	43, // 68: DeviceFilesystem.BootFile:output_type -> BootFileResponse
-	45, // 69: DeviceInfo.FetchFields:output_type -> FieldsResponse
-	47, // 70: DeviceNWA.NWACommand:output_type -> NWACommandResponse
-	50, // [50:71] is the sub-list for method output_type
-	29, // [29:50] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+
+// Deprecated: Use ListSramBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListSramBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{49}
 }
 
-func init() { file_sni_proto_init() }
-func file_sni_proto_init() {
-	if File_sni_proto != nil {
-		return
+func (x *ListSramBackupsResponse) GetBackups() []*SramBackup {
+	if x != nil {
+		return x.Backups
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesRequest); i {
+	return nil
+}
+
+type RestoreSramBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// game and id of the backup to restore; the most recent backup of the game is restored if `id` is empty
+	Rom string `protobuf:"bytes,2,opt,name=rom,proto3" json:"rom,omitempty"`
+	Id  string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// restore even if the backup was taken from a different game than the one currently running
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *RestoreSramBackupRequest) Reset() {
+	*x = RestoreSramBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSramBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSramBackupRequest) ProtoMessage() {}
+
+func (x *RestoreSramBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSramBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSramBackupRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestoreSramBackupRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RestoreSramBackupRequest) GetRom() string {
+	if x != nil {
+		return x.Rom
+	}
+	return ""
+}
+
+func (x *RestoreSramBackupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreSramBackupRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type RestoreSramBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Backup *SramBackup `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (x *RestoreSramBackupResponse) Reset() {
+	*x = RestoreSramBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSramBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSramBackupResponse) ProtoMessage() {}
+
+func (x *RestoreSramBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSramBackupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSramBackupResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RestoreSramBackupResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RestoreSramBackupResponse) GetBackup() *SramBackup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+type StartSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// name of a region to search in the FxPakPro address space, one of WRAM, SRAM, VRAM, APU, CGRAM or OAM; if empty,
+	// the region starting at `requestAddress` of `size` bytes is searched instead
+	Region               string        `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,3,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,4,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,5,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// size of the region in bytes; at most $100000
+	Size uint32 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// size of each value in bytes: 1, 2 or 3 for 8, 16 or 24-bit values; values are little-endian and every byte
+	// offset in the region is a candidate; defaults to 1
+	Width uint32 `protobuf:"varint,7,opt,name=width,proto3" json:"width,omitempty"`
+	// maximum number of candidates to return; defaults to 100
+	Limit uint32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *StartSearchRequest) Reset() {
+	*x = StartSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSearchRequest) ProtoMessage() {}
+
+func (x *StartSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSearchRequest.ProtoReflect.Descriptor instead.
+func (*StartSearchRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartSearchRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *StartSearchRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StartSearchRequest) GetRequestAddress() uint32 {
+	if x != nil {
+		return x.RequestAddress
+	}
+	return 0
+}
+
+func (x *StartSearchRequest) GetRequestAddressSpace() AddressSpace {
+	if x != nil {
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *StartSearchRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *StartSearchRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *StartSearchRequest) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *StartSearchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RefineSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId  string          `protobuf:"bytes,1,opt,name=searchId,proto3" json:"searchId,omitempty"`
+	Predicate SearchPredicate `protobuf:"varint,2,opt,name=predicate,proto3,enum=SearchPredicate" json:"predicate,omitempty"`
+	// value compared against by the *Value predicates
+	Value uint32 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// maximum number of candidates to return; defaults to 100
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RefineSearchRequest) Reset() {
+	*x = RefineSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefineSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefineSearchRequest) ProtoMessage() {}
+
+func (x *RefineSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefineSearchRequest.ProtoReflect.Descriptor instead.
+func (*RefineSearchRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RefineSearchRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *RefineSearchRequest) GetPredicate() SearchPredicate {
+	if x != nil {
+		return x.Predicate
+	}
+	return SearchPredicate_Unchanged
+}
+
+func (x *RefineSearchRequest) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RefineSearchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSearchResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId string `protobuf:"bytes,1,opt,name=searchId,proto3" json:"searchId,omitempty"`
+	// index of the first candidate to return
+	Start uint32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// maximum number of candidates to return; defaults to 100
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetSearchResultsRequest) Reset() {
+	*x = GetSearchResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSearchResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchResultsRequest) ProtoMessage() {}
+
+func (x *GetSearchResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchResultsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSearchResultsRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *GetSearchResultsRequest) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetSearchResultsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId             string        `protobuf:"bytes,1,opt,name=searchId,proto3" json:"searchId,omitempty"`
+	Uri                  string        `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,3,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Width                uint32        `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	// total number of candidates remaining
+	CandidateCount uint32                      `protobuf:"varint,6,opt,name=candidateCount,proto3" json:"candidateCount,omitempty"`
+	Candidates     []*SearchResponse_Candidate `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchResponse) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetRequestAddressSpace() AddressSpace {
+	if x != nil {
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *SearchResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *SearchResponse) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetCandidateCount() uint32 {
+	if x != nil {
+		return x.CandidateCount
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetCandidates() []*SearchResponse_Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type EndSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SearchId string `protobuf:"bytes,1,opt,name=searchId,proto3" json:"searchId,omitempty"`
+}
+
+func (x *EndSearchRequest) Reset() {
+	*x = EndSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSearchRequest) ProtoMessage() {}
+
+func (x *EndSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSearchRequest.ProtoReflect.Descriptor instead.
+func (*EndSearchRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EndSearchRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+type EndSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EndSearchResponse) Reset() {
+	*x = EndSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSearchResponse) ProtoMessage() {}
+
+func (x *EndSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSearchResponse.ProtoReflect.Descriptor instead.
+func (*EndSearchResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{57}
+}
+
+type Cheat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// code formatted in its own format
+	Code   string      `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Format CheatFormat `protobuf:"varint,4,opt,name=format,proto3,enum=CheatFormat" json:"format,omitempty"`
+	// address of the code on the SNES A bus:
+	Address uint32 `protobuf:"varint,5,opt,name=address,proto3" json:"address,omitempty"`
+	Value   uint32 `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+	// address of the code in the FX Pak Pro address space:
+	DeviceAddress uint32        `protobuf:"varint,7,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	MemoryMapping MemoryMapping `protobuf:"varint,8,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// true if the code patches ROM, false if it freezes RAM:
+	RomPatch bool `protobuf:"varint,9,opt,name=romPatch,proto3" json:"romPatch,omitempty"`
+	Enabled  bool `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *Cheat) Reset() {
+	*x = Cheat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cheat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cheat) ProtoMessage() {}
+
+func (x *Cheat) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cheat.ProtoReflect.Descriptor instead.
+func (*Cheat) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Cheat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cheat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cheat) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Cheat) GetFormat() CheatFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CheatFormat_GameGenie
+}
+
+func (x *Cheat) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *Cheat) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Cheat) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *Cheat) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *Cheat) GetRomPatch() bool {
+	if x != nil {
+		return x.RomPatch
+	}
+	return false
+}
+
+func (x *Cheat) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type AddCheatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// Game Genie code written as "XXXX-XXXX" or Pro Action Replay code written as "AAAAAAVV" or "AAAAAA:VV"
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// memory mapping used to map the code's address; detected from the ROM header if Unknown
+	MemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	Enabled       bool          `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *AddCheatRequest) Reset() {
+	*x = AddCheatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCheatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCheatRequest) ProtoMessage() {}
+
+func (x *AddCheatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCheatRequest.ProtoReflect.Descriptor instead.
+func (*AddCheatRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddCheatRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *AddCheatRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AddCheatRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddCheatRequest) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *AddCheatRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type AddCheatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri   string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cheat *Cheat `protobuf:"bytes,2,opt,name=cheat,proto3" json:"cheat,omitempty"`
+}
+
+func (x *AddCheatResponse) Reset() {
+	*x = AddCheatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCheatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCheatResponse) ProtoMessage() {}
+
+func (x *AddCheatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCheatResponse.ProtoReflect.Descriptor instead.
+func (*AddCheatResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddCheatResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *AddCheatResponse) GetCheat() *Cheat {
+	if x != nil {
+		return x.Cheat
+	}
+	return nil
+}
+
+type ListCheatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *ListCheatsRequest) Reset() {
+	*x = ListCheatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCheatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheatsRequest) ProtoMessage() {}
+
+func (x *ListCheatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheatsRequest.ProtoReflect.Descriptor instead.
+func (*ListCheatsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListCheatsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ListCheatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cheats []*Cheat `protobuf:"bytes,2,rep,name=cheats,proto3" json:"cheats,omitempty"`
+}
+
+func (x *ListCheatsResponse) Reset() {
+	*x = ListCheatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCheatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCheatsResponse) ProtoMessage() {}
+
+func (x *ListCheatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCheatsResponse.ProtoReflect.Descriptor instead.
+func (*ListCheatsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListCheatsResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ListCheatsResponse) GetCheats() []*Cheat {
+	if x != nil {
+		return x.Cheats
+	}
+	return nil
+}
+
+type SetCheatEnabledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetCheatEnabledRequest) Reset() {
+	*x = SetCheatEnabledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCheatEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCheatEnabledRequest) ProtoMessage() {}
+
+func (x *SetCheatEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCheatEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetCheatEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetCheatEnabledRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SetCheatEnabledRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetCheatEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetCheatEnabledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri   string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cheat *Cheat `protobuf:"bytes,2,opt,name=cheat,proto3" json:"cheat,omitempty"`
+}
+
+func (x *SetCheatEnabledResponse) Reset() {
+	*x = SetCheatEnabledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCheatEnabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCheatEnabledResponse) ProtoMessage() {}
+
+func (x *SetCheatEnabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCheatEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetCheatEnabledResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetCheatEnabledResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SetCheatEnabledResponse) GetCheat() *Cheat {
+	if x != nil {
+		return x.Cheat
+	}
+	return nil
+}
+
+type RemoveCheatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveCheatRequest) Reset() {
+	*x = RemoveCheatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCheatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCheatRequest) ProtoMessage() {}
+
+func (x *RemoveCheatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCheatRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCheatRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveCheatRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveCheatRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveCheatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *RemoveCheatResponse) Reset() {
+	*x = RemoveCheatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCheatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCheatResponse) ProtoMessage() {}
+
+func (x *RemoveCheatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCheatResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCheatResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveCheatResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type LoadSymbolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// load the symbols for the device with this uri, or if empty, for the ROM with `romSha1`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// SHA-1 of the ROM to load the symbols for in hex, as returned in the RomSHA1 field by FetchFields
+	RomSha1 string `protobuf:"bytes,5,opt,name=romSha1,proto3" json:"romSha1,omitempty"`
+	// contents of the symbol file
+	Data   []byte       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Format SymbolFormat `protobuf:"varint,4,opt,name=format,proto3,enum=SymbolFormat" json:"format,omitempty"`
+}
+
+func (x *LoadSymbolsRequest) Reset() {
+	*x = LoadSymbolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSymbolsRequest) ProtoMessage() {}
+
+func (x *LoadSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*LoadSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LoadSymbolsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *LoadSymbolsRequest) GetRomSha1() string {
+	if x != nil {
+		return x.RomSha1
+	}
+	return ""
+}
+
+func (x *LoadSymbolsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LoadSymbolsRequest) GetFormat() SymbolFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SymbolFormat_DetectSymbolFormat
+}
+
+type LoadSymbolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of labels loaded
+	LabelCount uint32 `protobuf:"varint,1,opt,name=labelCount,proto3" json:"labelCount,omitempty"`
+}
+
+func (x *LoadSymbolsResponse) Reset() {
+	*x = LoadSymbolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSymbolsResponse) ProtoMessage() {}
+
+func (x *LoadSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*LoadSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LoadSymbolsResponse) GetLabelCount() uint32 {
+	if x != nil {
+		return x.LabelCount
+	}
+	return 0
+}
+
+type UnloadSymbolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unload the symbols for the device with this uri, or if empty, for the ROM with `romSha1`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	RomSha1 string `protobuf:"bytes,3,opt,name=romSha1,proto3" json:"romSha1,omitempty"`
+}
+
+func (x *UnloadSymbolsRequest) Reset() {
+	*x = UnloadSymbolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadSymbolsRequest) ProtoMessage() {}
+
+func (x *UnloadSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*UnloadSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UnloadSymbolsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *UnloadSymbolsRequest) GetRomSha1() string {
+	if x != nil {
+		return x.RomSha1
+	}
+	return ""
+}
+
+type UnloadSymbolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// true if symbols were loaded
+	Unloaded bool `protobuf:"varint,1,opt,name=unloaded,proto3" json:"unloaded,omitempty"`
+}
+
+func (x *UnloadSymbolsResponse) Reset() {
+	*x = UnloadSymbolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadSymbolsResponse) ProtoMessage() {}
+
+func (x *UnloadSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*UnloadSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UnloadSymbolsResponse) GetUnloaded() bool {
+	if x != nil {
+		return x.Unloaded
+	}
+	return false
+}
+
+type ResolveLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// label expression, e.g. "Link_Health+2"
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// detected from the ROM header if Unknown
+	MemoryMapping MemoryMapping `protobuf:"varint,3,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+}
+
+func (x *ResolveLabelRequest) Reset() {
+	*x = ResolveLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLabelRequest) ProtoMessage() {}
+
+func (x *ResolveLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLabelRequest.ProtoReflect.Descriptor instead.
+func (*ResolveLabelRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResolveLabelRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ResolveLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ResolveLabelRequest) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+type ResolveLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri           string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Label         string        `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Address       uint32        `protobuf:"varint,3,opt,name=address,proto3" json:"address,omitempty"`
+	AddressSpace  AddressSpace  `protobuf:"varint,4,opt,name=addressSpace,proto3,enum=AddressSpace" json:"addressSpace,omitempty"`
+	MemoryMapping MemoryMapping `protobuf:"varint,5,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+}
+
+func (x *ResolveLabelResponse) Reset() {
+	*x = ResolveLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveLabelResponse) ProtoMessage() {}
+
+func (x *ResolveLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveLabelResponse.ProtoReflect.Descriptor instead.
+func (*ResolveLabelResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ResolveLabelResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ResolveLabelResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ResolveLabelResponse) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *ResolveLabelResponse) GetAddressSpace() AddressSpace {
+	if x != nil {
+		return x.AddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *ResolveLabelResponse) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+type ApplyPatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// contents of the patch file
+	Patch  []byte      `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	Format PatchFormat `protobuf:"varint,3,opt,name=format,proto3,enum=PatchFormat" json:"format,omitempty"`
+	Target PatchTarget `protobuf:"varint,4,opt,name=target,proto3,enum=PatchTarget" json:"target,omitempty"`
+	// path of the ROM file to patch when target is PatchFile
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	// path to write the patched ROM file to when target is PatchFile; if empty, the file at `path` is overwritten
+	OutputPath string `protobuf:"bytes,6,opt,name=outputPath,proto3" json:"outputPath,omitempty"`
+	// memory mapping used when target is PatchRomMemory; detected from the ROM header if not set and the device
+	// requires it
+	MemoryMapping *MemoryMapping `protobuf:"varint,7,opt,name=memoryMapping,proto3,enum=MemoryMapping,oneof" json:"memoryMapping,omitempty"`
+}
+
+func (x *ApplyPatchRequest) Reset() {
+	*x = ApplyPatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPatchRequest) ProtoMessage() {}
+
+func (x *ApplyPatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPatchRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPatchRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ApplyPatchRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ApplyPatchRequest) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *ApplyPatchRequest) GetFormat() PatchFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PatchFormat_DetectPatchFormat
+}
+
+func (x *ApplyPatchRequest) GetTarget() PatchTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PatchTarget_PatchRomMemory
+}
+
+func (x *ApplyPatchRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ApplyPatchRequest) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *ApplyPatchRequest) GetMemoryMapping() MemoryMapping {
+	if x != nil && x.MemoryMapping != nil {
+		return *x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+type ApplyPatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// format of the patch, as detected if not given
+	Format PatchFormat `protobuf:"varint,2,opt,name=format,proto3,enum=PatchFormat" json:"format,omitempty"`
+	// number of contiguous runs of bytes written to ROM, or 1 for a file
+	Writes uint32 `protobuf:"varint,3,opt,name=writes,proto3" json:"writes,omitempty"`
+	// size of the patched ROM in bytes
+	Size uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// path of the patched file when target is PatchFile
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ApplyPatchResponse) Reset() {
+	*x = ApplyPatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPatchResponse) ProtoMessage() {}
+
+func (x *ApplyPatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPatchResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPatchResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ApplyPatchResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ApplyPatchResponse) GetFormat() PatchFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PatchFormat_DetectPatchFormat
+}
+
+func (x *ApplyPatchResponse) GetWrites() uint32 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *ApplyPatchResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ApplyPatchResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ReadDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ReadDirectoryRequest) Reset() {
+	*x = ReadDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryRequest) ProtoMessage() {}
+
+func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReadDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type DirEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+}
+
+func (x *DirEntry) Reset() {
+	*x = DirEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirEntry) ProtoMessage() {}
+
+func (x *DirEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DirEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DirEntry) GetType() DirEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return DirEntryType_Directory
+}
+
+type ReadDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ReadDirectoryResponse) Reset() {
+	*x = ReadDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryResponse) ProtoMessage() {}
+
+func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReadDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MakeDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryRequest) Reset() {
+	*x = MakeDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryRequest) ProtoMessage() {}
+
+func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MakeDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MakeDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryResponse) Reset() {
+	*x = MakeDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryResponse) ProtoMessage() {}
+
+func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MakeDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileRequest) Reset() {
+	*x = RemoveFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileRequest) ProtoMessage() {}
+
+func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RemoveFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileResponse) Reset() {
+	*x = RemoveFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileResponse) ProtoMessage() {}
+
+func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RenameFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileRequest) Reset() {
+	*x = RenameFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileRequest) ProtoMessage() {}
+
+func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
+func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RenameFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type RenameFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileResponse) Reset() {
+	*x = RenameFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileResponse) ProtoMessage() {}
+
+func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
+func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RenameFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type PutFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PutFileRequest) Reset() {
+	*x = PutFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileRequest) ProtoMessage() {}
+
+func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
+func (*PutFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PutFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *PutFileResponse) Reset() {
+	*x = PutFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileResponse) ProtoMessage() {}
+
+func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
+func (*PutFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PutFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BootFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileRequest) Reset() {
+	*x = BootFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileRequest) ProtoMessage() {}
+
+func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
+func (*BootFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *BootFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BootFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileResponse) Reset() {
+	*x = BootFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileResponse) ProtoMessage() {}
+
+func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
+func (*BootFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BootFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+}
+
+func (x *FieldsRequest) Reset() {
+	*x = FieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsRequest) ProtoMessage() {}
+
+func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
+func (*FieldsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FieldsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsRequest) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type FieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *FieldsResponse) Reset() {
+	*x = FieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsResponse) ProtoMessage() {}
+
+func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
+func (*FieldsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FieldsResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsResponse) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *FieldsResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type NWACommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// emu-nwaccess command name:
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// command arguments:
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// an optional binary argument:
+	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
+}
+
+func (x *NWACommandRequest) Reset() {
+	*x = NWACommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandRequest) ProtoMessage() {}
+
+func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
+func (*NWACommandRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *NWACommandRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetBinaryArg() []byte {
+	if x != nil {
+		return x.BinaryArg
+	}
+	return nil
+}
+
+type NWACommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
+	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+}
+
+func (x *NWACommandResponse) Reset() {
+	*x = NWACommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse) ProtoMessage() {}
+
+func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *NWACommandResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
+	if x != nil {
+		return x.AsciiReply
+	}
+	return nil
+}
+
+func (x *NWACommandResponse) GetBinaryReplay() []byte {
+	if x != nil {
+		return x.BinaryReplay
+	}
+	return nil
+}
+
+type DevicesResponse_Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URI that describes exactly how to connect to the device, e.g.:
+	// RetroArch:  "ra://127.0.0.1:55355"
+	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
+	//             "fxpakpro://./COM4"                         (Windows)
+	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
+	// uri is used as the unique identifier of the device for clients to refer to
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// friendly display name of the device
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	// device kind, e.g. "fxpakpro", "retroarch", "lua"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// all device capabilities:
+	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
+	// default address space for the device:
+	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
+	// [DEPRECATED] console system supported, e.g. "snes", "n64"
+	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
+	//
+	// Deprecated: Do not use.
+	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+	// optional URI that identifies the same device across reconnects and port renames, e.g.:
+	// FX Pak Pro: "fxpakpro://id/usb-1-1.2" or "fxpakpro://alias/living-room"
+	// it can be used in place of uri in all requests; empty if the device cannot be identified reliably
+	StableUri string `protobuf:"bytes,7,opt,name=stableUri,proto3" json:"stableUri,omitempty"`
+}
+
+func (x *DevicesResponse_Device) Reset() {
+	*x = DevicesResponse_Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesResponse_Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesResponse_Device) ProtoMessage() {}
+
+func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
+func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *DevicesResponse_Device) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DefaultAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+// Deprecated: Do not use.
+func (x *DevicesResponse_Device) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetStableUri() string {
+	if x != nil {
+		return x.StableUri
+	}
+	return ""
+}
+
+type WatchDevicesResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DeviceEventType `protobuf:"varint,1,opt,name=type,proto3,enum=DeviceEventType" json:"type,omitempty"`
+	// for removed devices, the device as it was last detected:
+	Device *DevicesResponse_Device `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *WatchDevicesResponse_Event) Reset() {
+	*x = WatchDevicesResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDevicesResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevicesResponse_Event) ProtoMessage() {}
+
+func (x *WatchDevicesResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevicesResponse_Event.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse_Event) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *WatchDevicesResponse_Event) GetType() DeviceEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DeviceEventType_DeviceAdded
+}
+
+func (x *WatchDevicesResponse_Event) GetDevice() *DevicesResponse_Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type WriteVerificationFailure_Mismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index into MultiWriteMemoryRequest `requests` of the write that did not verify
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// offset of the first mismatched byte from the request address
+	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// number of mismatched bytes
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// contents of memory read back from the device for the whole write
+	Actual []byte `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *WriteVerificationFailure_Mismatch) Reset() {
+	*x = WriteVerificationFailure_Mismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteVerificationFailure_Mismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteVerificationFailure_Mismatch) ProtoMessage() {}
+
+func (x *WriteVerificationFailure_Mismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteVerificationFailure_Mismatch.ProtoReflect.Descriptor instead.
+func (*WriteVerificationFailure_Mismatch) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *WriteVerificationFailure_Mismatch) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *WriteVerificationFailure_Mismatch) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *WriteVerificationFailure_Mismatch) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WriteVerificationFailure_Mismatch) GetActual() []byte {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+type TypedValue_Array struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*TypedValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TypedValue_Array) Reset() {
+	*x = TypedValue_Array{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypedValue_Array) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedValue_Array) ProtoMessage() {}
+
+func (x *TypedValue_Array) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedValue_Array.ProtoReflect.Descriptor instead.
+func (*TypedValue_Array) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *TypedValue_Array) GetValues() []*TypedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type TypedValue_Bits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bits map[string]bool `protobuf:"bytes,1,rep,name=bits,proto3" json:"bits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *TypedValue_Bits) Reset() {
+	*x = TypedValue_Bits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypedValue_Bits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedValue_Bits) ProtoMessage() {}
+
+func (x *TypedValue_Bits) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedValue_Bits.ProtoReflect.Descriptor instead.
+func (*TypedValue_Bits) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *TypedValue_Bits) GetBits() map[string]bool {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+type DiffSnapshotsResponse_Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset of the change from the start of the region
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Before []byte `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  []byte `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *DiffSnapshotsResponse_Change) Reset() {
+	*x = DiffSnapshotsResponse_Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSnapshotsResponse_Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSnapshotsResponse_Change) ProtoMessage() {}
+
+func (x *DiffSnapshotsResponse_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSnapshotsResponse_Change.ProtoReflect.Descriptor instead.
+func (*DiffSnapshotsResponse_Change) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *DiffSnapshotsResponse_Change) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DiffSnapshotsResponse_Change) GetBefore() []byte {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *DiffSnapshotsResponse_Change) GetAfter() []byte {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type DiffSnapshotsResponse_RegionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region  *SnapshotRegion                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Changes []*DiffSnapshotsResponse_Change `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	// total number of bytes that differ
+	ChangedBytes uint32 `protobuf:"varint,3,opt,name=changedBytes,proto3" json:"changedBytes,omitempty"`
+}
+
+func (x *DiffSnapshotsResponse_RegionDiff) Reset() {
+	*x = DiffSnapshotsResponse_RegionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSnapshotsResponse_RegionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSnapshotsResponse_RegionDiff) ProtoMessage() {}
+
+func (x *DiffSnapshotsResponse_RegionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSnapshotsResponse_RegionDiff.ProtoReflect.Descriptor instead.
+func (*DiffSnapshotsResponse_RegionDiff) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (x *DiffSnapshotsResponse_RegionDiff) GetRegion() *SnapshotRegion {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *DiffSnapshotsResponse_RegionDiff) GetChanges() []*DiffSnapshotsResponse_Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffSnapshotsResponse_RegionDiff) GetChangedBytes() uint32 {
+	if x != nil {
+		return x.ChangedBytes
+	}
+	return 0
+}
+
+type SearchResponse_Candidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address of the value in the address space the search was started with
+	Address uint32 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	// value when last read
+	Value uint32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// value when read before that
+	PreviousValue uint32 `protobuf:"varint,3,opt,name=previousValue,proto3" json:"previousValue,omitempty"`
+}
+
+func (x *SearchResponse_Candidate) Reset() {
+	*x = SearchResponse_Candidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Candidate) ProtoMessage() {}
+
+func (x *SearchResponse_Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Candidate.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Candidate) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *SearchResponse_Candidate) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *SearchResponse_Candidate) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SearchResponse_Candidate) GetPreviousValue() uint32 {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return 0
+}
+
+type NWACommandResponse_NWAASCIIItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) Reset() {
+	*x = NWACommandResponse_NWAASCIIItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+
+func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{93, 0}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_sni_proto protoreflect.FileDescriptor
+
+var file_sni_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x06, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x72, 0x69, 0x22,
+	0xde, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x1a, 0x5e, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xd6, 0x01, 0x0a,
+	0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x49, 0x0a,
+	0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x15,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30,
+	0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
+	0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0x7d, 0x0a, 0x0e, 0x52, 0x6f, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x0f, 0x72,
+	0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75,
+	0x6d, 0x52, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x75, 0x6d, 0x52,
+	0x6f, 0x6d, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xe0, 0x05, 0x0a, 0x0f, 0x52, 0x6f, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x4d,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x69, 0x70, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
+	0x68, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x70, 0x73, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69,
+	0x70, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6d, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x6f, 0x6d, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x72, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x72, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
+	0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x12, 0x29, 0x0a, 0x0d,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x22, 0xf8, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d,
+	0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xe1, 0x02, 0x0a, 0x13,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22,
+	0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2c, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x18, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x18,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x2e, 0x4d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x1a, 0x66, 0x0a, 0x08, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x18, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x74, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x59, 0x61, 0x6d,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x59,
+	0x61, 0x6d, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x36, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc9, 0x02, 0x0a, 0x0a,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x75, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x48, 0x00, 0x52,
+	0x03, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
+	0x42, 0x69, 0x74, 0x73, 0x48, 0x00, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x1a,
+	0x2c, 0x0a, 0x05, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x6f, 0x0a,
+	0x04, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x2e, 0x42, 0x69, 0x74, 0x73, 0x2e, 0x42, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x62, 0x69, 0x74, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x42, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c,
+	0x0a, 0x14, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c,
+	0x0a, 0x19, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x19, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x13,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x14, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x52, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x0c, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x3c, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69,
+	0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e,
+	0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a,
+	0x13, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x14, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x29,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x17, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x66,
+	0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x61, 0x70, 0x22, 0xb9, 0x02, 0x0a, 0x15,
+	0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x4e, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x1a, 0x92, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0a, 0x53, 0x72, 0x61, 0x6d,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6d, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6d, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x6d, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x40, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72,
+	0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x52,
+	0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x23, 0x0a,
+	0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0xab, 0x02, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x61, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x1a, 0x61, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x05, 0x43,
+	0x68, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x43,
+	0x68, 0x65, 0x61, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x68, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52,
+	0x05, 0x63, 0x68, 0x65, 0x61, 0x74, 0x22, 0x25, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x46, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x06, 0x63,
+	0x68, 0x65, 0x61, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x61,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52,
+	0x05, 0x63, 0x68, 0x65, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x6d,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x35, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x55, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6d,
+	0x53, 0x68, 0x61, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6d, 0x53,
+	0x68, 0x61, 0x31, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0b, 0x72, 0x6f, 0x6d, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x33, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x22, 0xc1, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x34,
+	0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x22, 0x88, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22,
+	0x8c, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3c,
+	0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08,
+	0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b,
+	0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a,
+	0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x4e, 0x57, 0x41, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x09,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x22, 0xac, 0x02,
+	0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4e, 0x57, 0x41,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x73,
+	0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x88, 0x01,
+	0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74,
+	0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x2a, 0x33, 0x0a, 0x0c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e,
+	0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10,
+	0x02, 0x2a, 0x82, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c,
+	0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f,
+	0x4d, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x41, 0x31, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x53, 0x58, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x4c, 0x6f, 0x52, 0x4f, 0x4d,
+	0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x70, 0x65, 0x72, 0x46, 0x58, 0x10, 0x07, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x44, 0x44, 0x31, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x50, 0x43,
+	0x37, 0x31, 0x31, 0x30, 0x10, 0x09, 0x2a, 0x9b, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x54, 0x6f, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x45, 0x71, 0x75,
+	0x61, 0x6c, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x47,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10,
+	0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x10, 0x07, 0x2a, 0x31, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x61, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x6e, 0x69, 0x65,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x10, 0x01, 0x2a, 0x5c, 0x0a, 0x0c, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x57, 0x6c, 0x61, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x4e, 0x6f, 0x63, 0x61, 0x73, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x61, 0x36, 0x35, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x10, 0x03, 0x2a, 0x4e, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x70, 0x73, 0x50, 0x61, 0x74, 0x63, 0x68, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x70, 0x73,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x70, 0x73, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x10, 0x03, 0x2a, 0x30, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6d,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x2a, 0xb3, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x41, 0x53, 0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12,
+	0x11, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12,
+	0x0c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x2a, 0x87, 0x02,
+	0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f,
+	0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+	0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x28, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x10, 0x29, 0x12,
+	0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10,
+	0x2a, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x6f, 0x6d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x10, 0x2b, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x6f, 0x6d, 0x53, 0x48, 0x41, 0x31, 0x10, 0x2c, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x6f, 0x6d, 0x4d, 0x44, 0x35, 0x10, 0x2d, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x10, 0x3c, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x10, 0x3d, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x3e, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x3f, 0x2a, 0x48, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10,
+	0x02, 0x2a, 0x27, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x79, 0x0a, 0x07, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xaa, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65,
+	0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
+	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xa5, 0x05, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x52, 0x6f,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e, 0x52, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x52, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8b, 0x01, 0x0a, 0x0b, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x9b, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x54,
+	0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x54, 0x61,
+	0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa3, 0x01, 0x0a, 0x0b, 0x53, 0x72, 0x61, 0x6d, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72,
+	0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x72, 0x61,
+	0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x72, 0x61, 0x6d, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf5, 0x01, 0x0a,
+	0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x35, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x45, 0x6e,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x32, 0xfe, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x65, 0x61, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x43, 0x68, 0x65, 0x61,
+	0x74, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x65, 0x61, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x68, 0x65, 0x61, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x61, 0x74, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc6, 0x01, 0x0a, 0x07, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x12, 0x13, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x15,
+	0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x14, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x46,
+	0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x37, 0x0a,
+	0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x52,
+	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15,
+	0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
+	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x32, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x32, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x57,
+	0x41, 0x12, 0x37, 0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x12, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x0a, 0x15, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2e,
+	0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sni_proto_rawDescOnce sync.Once
+	file_sni_proto_rawDescData = file_sni_proto_rawDesc
+)
+
+func file_sni_proto_rawDescGZIP() []byte {
+	file_sni_proto_rawDescOnce.Do(func() {
+		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
+	})
+	return file_sni_proto_rawDescData
+}
+
+var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
+var file_sni_proto_goTypes = []interface{}{
+	(AddressSpace)(0),                         // 0: AddressSpace
+	(MemoryMapping)(0),                        // 1: MemoryMapping
+	(SearchPredicate)(0),                      // 2: SearchPredicate
+	(CheatFormat)(0),                          // 3: CheatFormat
+	(SymbolFormat)(0),                         // 4: SymbolFormat
+	(PatchFormat)(0),                          // 5: PatchFormat
+	(PatchTarget)(0),                          // 6: PatchTarget
+	(DeviceCapability)(0),                     // 7: DeviceCapability
+	(Field)(0),                                // 8: Field
+	(DeviceEventType)(0),                      // 9: DeviceEventType
+	(DirEntryType)(0),                         // 10: DirEntryType
+	(*DevicesRequest)(nil),                    // 11: DevicesRequest
+	(*DevicesResponse)(nil),                   // 12: DevicesResponse
+	(*WatchDevicesResponse)(nil),              // 13: WatchDevicesResponse
+	(*ResetSystemRequest)(nil),                // 14: ResetSystemRequest
+	(*ResetSystemResponse)(nil),               // 15: ResetSystemResponse
+	(*ResetToMenuRequest)(nil),                // 16: ResetToMenuRequest
+	(*ResetToMenuResponse)(nil),               // 17: ResetToMenuResponse
+	(*PauseEmulationRequest)(nil),             // 18: PauseEmulationRequest
+	(*PauseEmulationResponse)(nil),            // 19: PauseEmulationResponse
+	(*PauseToggleEmulationRequest)(nil),       // 20: PauseToggleEmulationRequest
+	(*PauseToggleEmulationResponse)(nil),      // 21: PauseToggleEmulationResponse
+	(*DetectMemoryMappingRequest)(nil),        // 22: DetectMemoryMappingRequest
+	(*DetectMemoryMappingResponse)(nil),       // 23: DetectMemoryMappingResponse
+	(*RomInfoRequest)(nil),                    // 24: RomInfoRequest
+	(*RomInfoResponse)(nil),                   // 25: RomInfoResponse
+	(*ReadMemoryRequest)(nil),                 // 26: ReadMemoryRequest
+	(*ReadMemoryResponse)(nil),                // 27: ReadMemoryResponse
+	(*WriteMemoryRequest)(nil),                // 28: WriteMemoryRequest
+	(*WriteMemoryResponse)(nil),               // 29: WriteMemoryResponse
+	(*SingleReadMemoryRequest)(nil),           // 30: SingleReadMemoryRequest
+	(*SingleReadMemoryResponse)(nil),          // 31: SingleReadMemoryResponse
+	(*SingleWriteMemoryRequest)(nil),          // 32: SingleWriteMemoryRequest
+	(*SingleWriteMemoryResponse)(nil),         // 33: SingleWriteMemoryResponse
+	(*MultiReadMemoryRequest)(nil),            // 34: MultiReadMemoryRequest
+	(*MultiReadMemoryResponse)(nil),           // 35: MultiReadMemoryResponse
+	(*MultiWriteMemoryRequest)(nil),           // 36: MultiWriteMemoryRequest
+	(*MultiWriteMemoryResponse)(nil),          // 37: MultiWriteMemoryResponse
+	(*WriteVerificationFailure)(nil),          // 38: WriteVerificationFailure
+	(*WatchMemoryRequest)(nil),                // 39: WatchMemoryRequest
+	(*WatchMemoryResponse)(nil),               // 40: WatchMemoryResponse
+	(*ReadTypedRequest)(nil),                  // 41: ReadTypedRequest
+	(*ReadTypedResponse)(nil),                 // 42: ReadTypedResponse
+	(*TypedValue)(nil),                        // 43: TypedValue
+	(*AcquireLeaseRequest)(nil),               // 44: AcquireLeaseRequest
+	(*AcquireLeaseResponse)(nil),              // 45: AcquireLeaseResponse
+	(*ReleaseLeaseRequest)(nil),               // 46: ReleaseLeaseRequest
+	(*ReleaseLeaseResponse)(nil),              // 47: ReleaseLeaseResponse
+	(*SnapshotRegion)(nil),                    // 48: SnapshotRegion
+	(*SnapshotInfo)(nil),                      // 49: SnapshotInfo
+	(*TakeSnapshotRequest)(nil),               // 50: TakeSnapshotRequest
+	(*TakeSnapshotResponse)(nil),              // 51: TakeSnapshotResponse
+	(*RestoreSnapshotRequest)(nil),            // 52: RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),           // 53: RestoreSnapshotResponse
+	(*ListSnapshotsRequest)(nil),              // 54: ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),             // 55: ListSnapshotsResponse
+	(*DiffSnapshotsRequest)(nil),              // 56: DiffSnapshotsRequest
+	(*DiffSnapshotsResponse)(nil),             // 57: DiffSnapshotsResponse
+	(*SramBackup)(nil),                        // 58: SramBackup
+	(*ListSramBackupsRequest)(nil),            // 59: ListSramBackupsRequest
+	(*ListSramBackupsResponse)(nil),           // 60: ListSramBackupsResponse
+	(*RestoreSramBackupRequest)(nil),          // 61: RestoreSramBackupRequest
+	(*RestoreSramBackupResponse)(nil),         // 62: RestoreSramBackupResponse
+	(*StartSearchRequest)(nil),                // 63: StartSearchRequest
+	(*RefineSearchRequest)(nil),               // 64: RefineSearchRequest
+	(*GetSearchResultsRequest)(nil),           // 65: GetSearchResultsRequest
+	(*SearchResponse)(nil),                    // 66: SearchResponse
+	(*EndSearchRequest)(nil),                  // 67: EndSearchRequest
+	(*EndSearchResponse)(nil),                 // 68: EndSearchResponse
+	(*Cheat)(nil),                             // 69: Cheat
+	(*AddCheatRequest)(nil),                   // 70: AddCheatRequest
+	(*AddCheatResponse)(nil),                  // 71: AddCheatResponse
+	(*ListCheatsRequest)(nil),                 // 72: ListCheatsRequest
+	(*ListCheatsResponse)(nil),                // 73: ListCheatsResponse
+	(*SetCheatEnabledRequest)(nil),            // 74: SetCheatEnabledRequest
+	(*SetCheatEnabledResponse)(nil),           // 75: SetCheatEnabledResponse
+	(*RemoveCheatRequest)(nil),                // 76: RemoveCheatRequest
+	(*RemoveCheatResponse)(nil),               // 77: RemoveCheatResponse
+	(*LoadSymbolsRequest)(nil),                // 78: LoadSymbolsRequest
+	(*LoadSymbolsResponse)(nil),               // 79: LoadSymbolsResponse
+	(*UnloadSymbolsRequest)(nil),              // 80: UnloadSymbolsRequest
+	(*UnloadSymbolsResponse)(nil),             // 81: UnloadSymbolsResponse
+	(*ResolveLabelRequest)(nil),               // 82: ResolveLabelRequest
+	(*ResolveLabelResponse)(nil),              // 83: ResolveLabelResponse
+	(*ApplyPatchRequest)(nil),                 // 84: ApplyPatchRequest
+	(*ApplyPatchResponse)(nil),                // 85: ApplyPatchResponse
+	(*ReadDirectoryRequest)(nil),              // 86: ReadDirectoryRequest
+	(*DirEntry)(nil),                          // 87: DirEntry
+	(*ReadDirectoryResponse)(nil),             // 88: ReadDirectoryResponse
+	(*MakeDirectoryRequest)(nil),              // 89: MakeDirectoryRequest
+	(*MakeDirectoryResponse)(nil),             // 90: MakeDirectoryResponse
+	(*RemoveFileRequest)(nil),                 // 91: RemoveFileRequest
+	(*RemoveFileResponse)(nil),                // 92: RemoveFileResponse
+	(*RenameFileRequest)(nil),                 // 93: RenameFileRequest
+	(*RenameFileResponse)(nil),                // 94: RenameFileResponse
+	(*PutFileRequest)(nil),                    // 95: PutFileRequest
+	(*PutFileResponse)(nil),                   // 96: PutFileResponse
+	(*GetFileRequest)(nil),                    // 97: GetFileRequest
+	(*GetFileResponse)(nil),                   // 98: GetFileResponse
+	(*BootFileRequest)(nil),                   // 99: BootFileRequest
+	(*BootFileResponse)(nil),                  // 100: BootFileResponse
+	(*FieldsRequest)(nil),                     // 101: FieldsRequest
+	(*FieldsResponse)(nil),                    // 102: FieldsResponse
+	(*NWACommandRequest)(nil),                 // 103: NWACommandRequest
+	(*NWACommandResponse)(nil),                // 104: NWACommandResponse
+	(*DevicesResponse_Device)(nil),            // 105: DevicesResponse.Device
+	(*WatchDevicesResponse_Event)(nil),        // 106: WatchDevicesResponse.Event
+	(*WriteVerificationFailure_Mismatch)(nil), // 107: WriteVerificationFailure.Mismatch
+	nil,                                      // 108: ReadTypedResponse.ValuesEntry
+	(*TypedValue_Array)(nil),                 // 109: TypedValue.Array
+	(*TypedValue_Bits)(nil),                  // 110: TypedValue.Bits
+	nil,                                      // 111: TypedValue.Bits.BitsEntry
+	(*DiffSnapshotsResponse_Change)(nil),     // 112: DiffSnapshotsResponse.Change
+	(*DiffSnapshotsResponse_RegionDiff)(nil), // 113: DiffSnapshotsResponse.RegionDiff
+	(*SearchResponse_Candidate)(nil),         // 114: SearchResponse.Candidate
+	(*NWACommandResponse_NWAASCIIItem)(nil),  // 115: NWACommandResponse.NWAASCIIItem
+	nil,                                      // 116: NWACommandResponse.NWAASCIIItem.ItemEntry
+}
+var file_sni_proto_depIdxs = []int32{
+	105, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
+	106, // 1: WatchDevicesResponse.events:type_name -> WatchDevicesResponse.Event
+	105, // 2: WatchDevicesResponse.devices:type_name -> DevicesResponse.Device
+	1,   // 3: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
+	1,   // 4: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
+	1,   // 5: RomInfoResponse.memoryMapping:type_name -> MemoryMapping
+	0,   // 6: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,   // 7: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 8: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,   // 9: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 10: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	0,   // 11: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,   // 12: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 13: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,   // 14: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 15: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	26,  // 16: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
+	27,  // 17: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
+	28,  // 18: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
+	29,  // 19: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
+	26,  // 20: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
+	27,  // 21: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
+	28,  // 22: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
+	29,  // 23: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
+	29,  // 24: WriteVerificationFailure.responses:type_name -> WriteMemoryResponse
+	107, // 25: WriteVerificationFailure.mismatches:type_name -> WriteVerificationFailure.Mismatch
+	26,  // 26: WatchMemoryRequest.requests:type_name -> ReadMemoryRequest
+	27,  // 27: WatchMemoryResponse.responses:type_name -> ReadMemoryResponse
+	108, // 28: ReadTypedResponse.values:type_name -> ReadTypedResponse.ValuesEntry
+	110, // 29: TypedValue.bits:type_name -> TypedValue.Bits
+	109, // 30: TypedValue.array:type_name -> TypedValue.Array
+	1,   // 31: SnapshotInfo.memoryMapping:type_name -> MemoryMapping
+	48,  // 32: SnapshotInfo.regions:type_name -> SnapshotRegion
+	49,  // 33: TakeSnapshotResponse.snapshot:type_name -> SnapshotInfo
+	49,  // 34: RestoreSnapshotResponse.snapshot:type_name -> SnapshotInfo
+	48,  // 35: RestoreSnapshotResponse.restored:type_name -> SnapshotRegion
+	48,  // 36: RestoreSnapshotResponse.skipped:type_name -> SnapshotRegion
+	49,  // 37: ListSnapshotsResponse.snapshots:type_name -> SnapshotInfo
+	113, // 38: DiffSnapshotsResponse.regions:type_name -> DiffSnapshotsResponse.RegionDiff
+	58,  // 39: ListSramBackupsResponse.backups:type_name -> SramBackup
+	58,  // 40: RestoreSramBackupResponse.backup:type_name -> SramBackup
+	0,   // 41: StartSearchRequest.requestAddressSpace:type_name -> AddressSpace
+	1,   // 42: StartSearchRequest.requestMemoryMapping:type_name -> MemoryMapping
+	2,   // 43: RefineSearchRequest.predicate:type_name -> SearchPredicate
+	0,   // 44: SearchResponse.requestAddressSpace:type_name -> AddressSpace
+	1,   // 45: SearchResponse.requestMemoryMapping:type_name -> MemoryMapping
+	114, // 46: SearchResponse.candidates:type_name -> SearchResponse.Candidate
+	3,   // 47: Cheat.format:type_name -> CheatFormat
+	1,   // 48: Cheat.memoryMapping:type_name -> MemoryMapping
+	1,   // 49: AddCheatRequest.memoryMapping:type_name -> MemoryMapping
+	69,  // 50: AddCheatResponse.cheat:type_name -> Cheat
+	69,  // 51: ListCheatsResponse.cheats:type_name -> Cheat
+	69,  // 52: SetCheatEnabledResponse.cheat:type_name -> Cheat
+	4,   // 53: LoadSymbolsRequest.format:type_name -> SymbolFormat
+	1,   // 54: ResolveLabelRequest.memoryMapping:type_name -> MemoryMapping
+	0,   // 55: ResolveLabelResponse.addressSpace:type_name -> AddressSpace
+	1,   // 56: ResolveLabelResponse.memoryMapping:type_name -> MemoryMapping
+	5,   // 57: ApplyPatchRequest.format:type_name -> PatchFormat
+	6,   // 58: ApplyPatchRequest.target:type_name -> PatchTarget
+	1,   // 59: ApplyPatchRequest.memoryMapping:type_name -> MemoryMapping
+	5,   // 60: ApplyPatchResponse.format:type_name -> PatchFormat
+	10,  // 61: DirEntry.type:type_name -> DirEntryType
+	87,  // 62: ReadDirectoryResponse.entries:type_name -> DirEntry
+	8,   // 63: FieldsRequest.fields:type_name -> Field
+	8,   // 64: FieldsResponse.fields:type_name -> Field
+	115, // 65: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
+	7,   // 66: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
+	0,   // 67: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
+	9,   // 68: WatchDevicesResponse.Event.type:type_name -> DeviceEventType
+	105, // 69: WatchDevicesResponse.Event.device:type_name -> DevicesResponse.Device
+	43,  // 70: ReadTypedResponse.ValuesEntry.value:type_name -> TypedValue
+	43,  // 71: TypedValue.Array.values:type_name -> TypedValue
+	111, // 72: TypedValue.Bits.bits:type_name -> TypedValue.Bits.BitsEntry
+	48,  // 73: DiffSnapshotsResponse.RegionDiff.region:type_name -> SnapshotRegion
+	112, // 74: DiffSnapshotsResponse.RegionDiff.changes:type_name -> DiffSnapshotsResponse.Change
+	116, // 75: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
+	11,  // 76: Devices.ListDevices:input_type -> DevicesRequest
+	11,  // 77: Devices.WatchDevices:input_type -> DevicesRequest
+	14,  // 78: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
+	16,  // 79: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
+	18,  // 80: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
+	20,  // 81: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
+	22,  // 82: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
+	30,  // 83: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
+	32,  // 84: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
+	34,  // 85: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
+	36,  // 86: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
+	34,  // 87: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
+	36,  // 88: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
+	39,  // 89: DeviceMemory.WatchMemory:input_type -> WatchMemoryRequest
+	41,  // 90: DeviceMemory.ReadTyped:input_type -> ReadTypedRequest
+	24,  // 91: DeviceMemory.RomInfo:input_type -> RomInfoRequest
+	44,  // 92: DeviceLease.AcquireLease:input_type -> AcquireLeaseRequest
+	46,  // 93: DeviceLease.ReleaseLease:input_type -> ReleaseLeaseRequest
+	50,  // 94: DeviceSnapshot.TakeSnapshot:input_type -> TakeSnapshotRequest
+	52,  // 95: DeviceSnapshot.RestoreSnapshot:input_type -> RestoreSnapshotRequest
+	54,  // 96: DeviceSnapshot.ListSnapshots:input_type -> ListSnapshotsRequest
+	56,  // 97: DeviceSnapshot.DiffSnapshots:input_type -> DiffSnapshotsRequest
+	59,  // 98: SramBackups.ListSramBackups:input_type -> ListSramBackupsRequest
+	61,  // 99: SramBackups.RestoreSramBackup:input_type -> RestoreSramBackupRequest
+	63,  // 100: MemorySearch.StartSearch:input_type -> StartSearchRequest
+	64,  // 101: MemorySearch.RefineSearch:input_type -> RefineSearchRequest
+	65,  // 102: MemorySearch.GetSearchResults:input_type -> GetSearchResultsRequest
+	67,  // 103: MemorySearch.EndSearch:input_type -> EndSearchRequest
+	70,  // 104: DeviceCheats.AddCheat:input_type -> AddCheatRequest
+	72,  // 105: DeviceCheats.ListCheats:input_type -> ListCheatsRequest
+	74,  // 106: DeviceCheats.SetCheatEnabled:input_type -> SetCheatEnabledRequest
+	76,  // 107: DeviceCheats.RemoveCheat:input_type -> RemoveCheatRequest
+	78,  // 108: Symbols.LoadSymbols:input_type -> LoadSymbolsRequest
+	80,  // 109: Symbols.UnloadSymbols:input_type -> UnloadSymbolsRequest
+	82,  // 110: Symbols.ResolveLabel:input_type -> ResolveLabelRequest
+	84,  // 111: DevicePatch.ApplyPatch:input_type -> ApplyPatchRequest
+	86,  // 112: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
+	89,  // 113: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
+	91,  // 114: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
+	93,  // 115: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
+	95,  // 116: DeviceFilesystem.PutFile:input_type -> PutFileRequest
+	97,  // 117: DeviceFilesystem.GetFile:input_type -> GetFileRequest
+	99,  // 118: DeviceFilesystem.BootFile:input_type -> BootFileRequest
+	101, // 119: DeviceInfo.FetchFields:input_type -> FieldsRequest
+	103, // 120: DeviceNWA.NWACommand:input_type -> NWACommandRequest
+	12,  // 121: Devices.ListDevices:output_type -> DevicesResponse
+	13,  // 122: Devices.WatchDevices:output_type -> WatchDevicesResponse
+	15,  // 123: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
+	17,  // 124: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
+	19,  // 125: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
+	21,  // 126: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
+	23,  // 127: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
+	31,  // 128: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
+	33,  // 129: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
+	35,  // 130: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
+	37,  // 131: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
+	35,  // 132: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
+	37,  // 133: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
+	40,  // 134: DeviceMemory.WatchMemory:output_type -> WatchMemoryResponse
+	42,  // 135: DeviceMemory.ReadTyped:output_type -> ReadTypedResponse
+	25,  // 136: DeviceMemory.RomInfo:output_type -> RomInfoResponse
+	45,  // 137: DeviceLease.AcquireLease:output_type -> AcquireLeaseResponse
+	47,  // 138: DeviceLease.ReleaseLease:output_type -> ReleaseLeaseResponse
+	51,  // 139: DeviceSnapshot.TakeSnapshot:output_type -> TakeSnapshotResponse
+	53,  // 140: DeviceSnapshot.RestoreSnapshot:output_type -> RestoreSnapshotResponse
+	55,  // 141: DeviceSnapshot.ListSnapshots:output_type -> ListSnapshotsResponse
+	57,  // 142: DeviceSnapshot.DiffSnapshots:output_type -> DiffSnapshotsResponse
+	60,  // 143: SramBackups.ListSramBackups:output_type -> ListSramBackupsResponse
+	62,  // 144: SramBackups.RestoreSramBackup:output_type -> RestoreSramBackupResponse
+	66,  // 145: MemorySearch.StartSearch:output_type -> SearchResponse
+	66,  // 146: MemorySearch.RefineSearch:output_type -> SearchResponse
+	66,  // 147: MemorySearch.GetSearchResults:output_type -> SearchResponse
+	68,  // 148: MemorySearch.EndSearch:output_type -> EndSearchResponse
+	71,  // 149: DeviceCheats.AddCheat:output_type -> AddCheatResponse
+	73,  // 150: DeviceCheats.ListCheats:output_type -> ListCheatsResponse
+	75,  // 151: DeviceCheats.SetCheatEnabled:output_type -> SetCheatEnabledResponse
+	77,  // 152: DeviceCheats.RemoveCheat:output_type -> RemoveCheatResponse
+	79,  // 153: Symbols.LoadSymbols:output_type -> LoadSymbolsResponse
+	81,  // 154: Symbols.UnloadSymbols:output_type -> UnloadSymbolsResponse
+	83,  // 155: Symbols.ResolveLabel:output_type -> ResolveLabelResponse
+	85,  // 156: DevicePatch.ApplyPatch:output_type -> ApplyPatchResponse
+	88,  // 157: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
+	90,  // 158: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
+	92,  // 159: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
+	94,  // 160: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
+	96,  // 161: DeviceFilesystem.PutFile:output_type -> PutFileResponse
+	98,  // 162: DeviceFilesystem.GetFile:output_type -> GetFileResponse
+	100, // 163: DeviceFilesystem.BootFile:output_type -> BootFileResponse
+	102, // 164: DeviceInfo.FetchFields:output_type -> FieldsResponse
+	104, // 165: DeviceNWA.NWACommand:output_type -> NWACommandResponse
+	121, // [121:166] is the sub-list for method output_type
+	76,  // [76:121] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
+}
+
+func init() { file_sni_proto_init() }
+func file_sni_proto_init() {
+	if File_sni_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RomInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RomInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVerificationFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTypedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTypedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypedValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRegion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TakeSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TakeSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3610,8 +9303,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesResponse); i {
+		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3622,8 +9315,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemRequest); i {
+		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3634,8 +9327,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemResponse); i {
+		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3646,8 +9339,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuRequest); i {
+		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3658,8 +9351,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuResponse); i {
+		file_sni_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3670,8 +9363,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationRequest); i {
+		file_sni_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3682,8 +9375,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationResponse); i {
+		file_sni_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SramBackup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3694,8 +9387,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationRequest); i {
+		file_sni_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSramBackupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3706,8 +9399,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationResponse); i {
+		file_sni_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSramBackupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3718,8 +9411,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingRequest); i {
+		file_sni_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSramBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3730,8 +9423,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingResponse); i {
+		file_sni_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSramBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3742,8 +9435,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryRequest); i {
+		file_sni_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3754,8 +9447,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryResponse); i {
+		file_sni_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefineSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3766,8 +9459,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryRequest); i {
+		file_sni_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSearchResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3778,8 +9471,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryResponse); i {
+		file_sni_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3790,8 +9483,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryRequest); i {
+		file_sni_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3802,8 +9495,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryResponse); i {
+		file_sni_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3814,8 +9507,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cheat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3826,8 +9519,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCheatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3838,8 +9531,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryRequest); i {
+		file_sni_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCheatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3850,8 +9543,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryResponse); i {
+		file_sni_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCheatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3862,8 +9555,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCheatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3874,8 +9567,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCheatEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3886,7 +9579,139 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCheatEnabledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCheatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCheatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSymbolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSymbolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnloadSymbolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnloadSymbolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirectoryRequest); i {
 			case 0:
 				return &v.state
@@ -3898,7 +9723,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DirEntry); i {
 			case 0:
 				return &v.state
@@ -3910,7 +9735,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirectoryResponse); i {
 			case 0:
 				return &v.state
@@ -3922,7 +9747,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MakeDirectoryRequest); i {
 			case 0:
 				return &v.state
@@ -3934,7 +9759,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MakeDirectoryResponse); i {
 			case 0:
 				return &v.state
@@ -3946,7 +9771,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveFileRequest); i {
 			case 0:
 				return &v.state
@@ -3958,7 +9783,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveFileResponse); i {
 			case 0:
 				return &v.state
@@ -3970,7 +9795,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileRequest); i {
 			case 0:
 				return &v.state
@@ -3982,7 +9807,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileResponse); i {
 			case 0:
 				return &v.state
@@ -3994,7 +9819,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileRequest); i {
 			case 0:
 				return &v.state
@@ -4006,7 +9831,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileResponse); i {
 			case 0:
 				return &v.state
@@ -4018,7 +9843,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
@@ -4030,7 +9855,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFileResponse); i {
 			case 0:
 				return &v.state
@@ -4042,7 +9867,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootFileRequest); i {
 			case 0:
 				return &v.state
@@ -4054,7 +9879,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootFileResponse); i {
 			case 0:
 				return &v.state
@@ -4066,7 +9891,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FieldsRequest); i {
 			case 0:
 				return &v.state
@@ -4078,7 +9903,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FieldsResponse); i {
 			case 0:
 				return &v.state
@@ -4090,7 +9915,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NWACommandRequest); i {
 			case 0:
 				return &v.state
@@ -4102,7 +9927,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NWACommandResponse); i {
 			case 0:
 				return &v.state
@@ -4114,7 +9939,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DevicesResponse_Device); i {
 			case 0:
 				return &v.state
@@ -4126,7 +9951,91 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVerificationFailure_Mismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypedValue_Array); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypedValue_Bits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSnapshotsResponse_Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSnapshotsResponse_RegionDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Candidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NWACommandResponse_NWAASCIIItem); i {
 			case 0:
 				return &v.state
@@ -4139,18 +10048,30 @@ func file_sni_proto_init() {
 			}
 		}
 	}
-	file_sni_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[41].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*TypedValue_Uint)(nil),
+		(*TypedValue_Int)(nil),
+		(*TypedValue_Bits_)(nil),
+		(*TypedValue_Bytes)(nil),
+		(*TypedValue_Array_)(nil),
+	}
+	file_sni_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[92].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[93].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sni_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   46,
+			NumEnums:      11,
+			NumMessages:   106,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   13,
 		},
 		GoTypes:           file_sni_proto_goTypes,
 		DependencyIndexes: file_sni_proto_depIdxs,
@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type DevicesClient interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error)
+	// stream device added, removed and changed events as devices are connected and disconnected:
+	WatchDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error)
 }
 
 type devicesClient struct {
@@ -43,12 +45,46 @@ func (c *devicesClient) ListDevices(ctx context.Context, in *DevicesRequest, opt
 	return out, nil
 }
 
+func (c *devicesClient) WatchDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Devices_ServiceDesc.Streams[0], "/Devices/WatchDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &devicesWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Devices_WatchDevicesClient interface {
+	Recv() (*WatchDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type devicesWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *devicesWatchDevicesClient) Recv() (*WatchDevicesResponse, error) {
+	m := new(WatchDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DevicesServer is the server API for Devices service.
 // All implementations must embed UnimplementedDevicesServer
 // for forward compatibility
 type DevicesServer interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error)
+	// stream device added, removed and changed events as devices are connected and disconnected:
+	WatchDevices(*DevicesRequest, Devices_WatchDevicesServer) error
 	mustEmbedUnimplementedDevicesServer()
 }
 
@@ -59,6 +95,9 @@ type UnimplementedDevicesServer struct {
 func (UnimplementedDevicesServer) ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
 }
+func (UnimplementedDevicesServer) WatchDevices(*DevicesRequest, Devices_WatchDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
 func (UnimplementedDevicesServer) mustEmbedUnimplementedDevicesServer() {}
 
 // UnsafeDevicesServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +129,27 @@ func _Devices_ListDevices_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Devices_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevicesServer).WatchDevices(m, &devicesWatchDevicesServer{stream})
+}
+
+type Devices_WatchDevicesServer interface {
+	Send(*WatchDevicesResponse) error
+	grpc.ServerStream
+}
+
+type devicesWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *devicesWatchDevicesServer) Send(m *WatchDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Devices_ServiceDesc is the grpc.ServiceDesc for Devices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -102,7 +162,13 @@ var Devices_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Devices_ListDevices_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _Devices_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "sni.proto",
 }
 
@@ -326,6 +392,13 @@ type DeviceMemoryClient interface {
 	StreamRead(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamReadClient, error)
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamWriteClient, error)
+	// watch multiple memory segments for changes on the given device; a response is streamed back only when
+	// the contents of at least one watched segment has changed since the last response:
+	WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error)
+	// read the fields described by a schema from the given device and decode them into typed values:
+	ReadTyped(ctx context.Context, in *ReadTypedRequest, opts ...grpc.CallOption) (*ReadTypedResponse, error)
+	// read the ROM header from 00:FFB0 for the given device and parse it into its fields:
+	RomInfo(ctx context.Context, in *RomInfoRequest, opts ...grpc.CallOption) (*RomInfoResponse, error)
 }
 
 type deviceMemoryClient struct {
@@ -443,6 +516,56 @@ func (x *deviceMemoryStreamWriteClient) Recv() (*MultiWriteMemoryResponse, error
 	return m, nil
 }
 
+func (c *deviceMemoryClient) WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceMemory_ServiceDesc.Streams[2], "/DeviceMemory/WatchMemory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceMemoryWatchMemoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceMemory_WatchMemoryClient interface {
+	Recv() (*WatchMemoryResponse, error)
+	grpc.ClientStream
+}
+
+type deviceMemoryWatchMemoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceMemoryWatchMemoryClient) Recv() (*WatchMemoryResponse, error) {
+	m := new(WatchMemoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceMemoryClient) ReadTyped(ctx context.Context, in *ReadTypedRequest, opts ...grpc.CallOption) (*ReadTypedResponse, error) {
+	out := new(ReadTypedResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/ReadTyped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMemoryClient) RomInfo(ctx context.Context, in *RomInfoRequest, opts ...grpc.CallOption) (*RomInfoResponse, error) {
+	out := new(RomInfoResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/RomInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceMemoryServer is the server API for DeviceMemory service.
 // All implementations must embed UnimplementedDeviceMemoryServer
 // for forward compatibility
@@ -461,6 +584,13 @@ type DeviceMemoryServer interface {
 	StreamRead(DeviceMemory_StreamReadServer) error
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(DeviceMemory_StreamWriteServer) error
+	// watch multiple memory segments for changes on the given device; a response is streamed back only when
+	// the contents of at least one watched segment has changed since the last response:
+	WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error
+	// read the fields described by a schema from the given device and decode them into typed values:
+	ReadTyped(context.Context, *ReadTypedRequest) (*ReadTypedResponse, error)
+	// read the ROM header from 00:FFB0 for the given device and parse it into its fields:
+	RomInfo(context.Context, *RomInfoRequest) (*RomInfoResponse, error)
 	mustEmbedUnimplementedDeviceMemoryServer()
 }
 
@@ -489,6 +619,15 @@ func (UnimplementedDeviceMemoryServer) StreamRead(DeviceMemory_StreamReadServer)
 func (UnimplementedDeviceMemoryServer) StreamWrite(DeviceMemory_StreamWriteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWrite not implemented")
 }
+func (UnimplementedDeviceMemoryServer) WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMemory not implemented")
+}
+func (UnimplementedDeviceMemoryServer) ReadTyped(context.Context, *ReadTypedRequest) (*ReadTypedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTyped not implemented")
+}
+func (UnimplementedDeviceMemoryServer) RomInfo(context.Context, *RomInfoRequest) (*RomInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RomInfo not implemented")
+}
 func (UnimplementedDeviceMemoryServer) mustEmbedUnimplementedDeviceMemoryServer() {}
 
 // UnsafeDeviceMemoryServer may be embedded to opt out of forward compatibility for this service.
@@ -644,6 +783,63 @@ func (x *deviceMemoryStreamWriteServer) Recv() (*MultiWriteMemoryRequest, error)
 	return m, nil
 }
 
+func _DeviceMemory_WatchMemory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMemoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceMemoryServer).WatchMemory(m, &deviceMemoryWatchMemoryServer{stream})
+}
+
+type DeviceMemory_WatchMemoryServer interface {
+	Send(*WatchMemoryResponse) error
+	grpc.ServerStream
+}
+
+type deviceMemoryWatchMemoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceMemoryWatchMemoryServer) Send(m *WatchMemoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceMemory_ReadTyped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTypedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).ReadTyped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/ReadTyped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).ReadTyped(ctx, req.(*ReadTypedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMemory_RomInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RomInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).RomInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/RomInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).RomInfo(ctx, req.(*RomInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceMemory_ServiceDesc is the grpc.ServiceDesc for DeviceMemory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -671,6 +867,14 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiWrite",
 			Handler:    _DeviceMemory_MultiWrite_Handler,
 		},
+		{
+			MethodName: "ReadTyped",
+			Handler:    _DeviceMemory_ReadTyped_Handler,
+		},
+		{
+			MethodName: "RomInfo",
+			Handler:    _DeviceMemory_RomInfo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -685,7 +889,1122 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchMemory",
+			Handler:       _DeviceMemory_WatchMemory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sni.proto",
+}
+
+// DeviceLeaseClient is the client API for DeviceLease service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceLeaseClient interface {
+	// acquire exclusive use of the device or renew a lease already held:
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error)
+	// release a lease before it expires:
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error)
+}
+
+type deviceLeaseClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceLeaseClient(cc grpc.ClientConnInterface) DeviceLeaseClient {
+	return &deviceLeaseClient{cc}
+}
+
+func (c *deviceLeaseClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error) {
+	out := new(AcquireLeaseResponse)
+	err := c.cc.Invoke(ctx, "/DeviceLease/AcquireLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceLeaseClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error) {
+	out := new(ReleaseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/DeviceLease/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceLeaseServer is the server API for DeviceLease service.
+// All implementations must embed UnimplementedDeviceLeaseServer
+// for forward compatibility
+type DeviceLeaseServer interface {
+	// acquire exclusive use of the device or renew a lease already held:
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error)
+	// release a lease before it expires:
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error)
+	mustEmbedUnimplementedDeviceLeaseServer()
+}
+
+// UnimplementedDeviceLeaseServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceLeaseServer struct {
+}
+
+func (UnimplementedDeviceLeaseServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedDeviceLeaseServer) ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedDeviceLeaseServer) mustEmbedUnimplementedDeviceLeaseServer() {}
+
+// UnsafeDeviceLeaseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceLeaseServer will
+// result in compilation errors.
+type UnsafeDeviceLeaseServer interface {
+	mustEmbedUnimplementedDeviceLeaseServer()
+}
+
+func RegisterDeviceLeaseServer(s grpc.ServiceRegistrar, srv DeviceLeaseServer) {
+	s.RegisterService(&DeviceLease_ServiceDesc, srv)
+}
+
+func _DeviceLease_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceLeaseServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceLease/AcquireLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceLeaseServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceLease_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceLeaseServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceLease/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceLeaseServer).ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceLease_ServiceDesc is the grpc.ServiceDesc for DeviceLease service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceLease_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceLease",
+	HandlerType: (*DeviceLeaseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcquireLease",
+			Handler:    _DeviceLease_AcquireLease_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _DeviceLease_ReleaseLease_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// DeviceSnapshotClient is the client API for DeviceSnapshot service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceSnapshotClient interface {
+	// capture memory regions of the game currently running on the device to a named snapshot:
+	TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error)
+	// write the memory regions of a snapshot back to the device:
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	// list all stored snapshots, most recent first:
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// compare a snapshot with another snapshot or with the current memory of a device:
+	DiffSnapshots(ctx context.Context, in *DiffSnapshotsRequest, opts ...grpc.CallOption) (*DiffSnapshotsResponse, error)
+}
+
+type deviceSnapshotClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceSnapshotClient(cc grpc.ClientConnInterface) DeviceSnapshotClient {
+	return &deviceSnapshotClient{cc}
+}
+
+func (c *deviceSnapshotClient) TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error) {
+	out := new(TakeSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/TakeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceSnapshotClient) DiffSnapshots(ctx context.Context, in *DiffSnapshotsRequest, opts ...grpc.CallOption) (*DiffSnapshotsResponse, error) {
+	out := new(DiffSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceSnapshot/DiffSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceSnapshotServer is the server API for DeviceSnapshot service.
+// All implementations must embed UnimplementedDeviceSnapshotServer
+// for forward compatibility
+type DeviceSnapshotServer interface {
+	// capture memory regions of the game currently running on the device to a named snapshot:
+	TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error)
+	// write the memory regions of a snapshot back to the device:
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	// list all stored snapshots, most recent first:
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// compare a snapshot with another snapshot or with the current memory of a device:
+	DiffSnapshots(context.Context, *DiffSnapshotsRequest) (*DiffSnapshotsResponse, error)
+	mustEmbedUnimplementedDeviceSnapshotServer()
+}
+
+// UnimplementedDeviceSnapshotServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceSnapshotServer struct {
+}
+
+func (UnimplementedDeviceSnapshotServer) TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeSnapshot not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) DiffSnapshots(context.Context, *DiffSnapshotsRequest) (*DiffSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSnapshots not implemented")
+}
+func (UnimplementedDeviceSnapshotServer) mustEmbedUnimplementedDeviceSnapshotServer() {}
+
+// UnsafeDeviceSnapshotServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceSnapshotServer will
+// result in compilation errors.
+type UnsafeDeviceSnapshotServer interface {
+	mustEmbedUnimplementedDeviceSnapshotServer()
+}
+
+func RegisterDeviceSnapshotServer(s grpc.ServiceRegistrar, srv DeviceSnapshotServer) {
+	s.RegisterService(&DeviceSnapshot_ServiceDesc, srv)
+}
+
+func _DeviceSnapshot_TakeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).TakeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/TakeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).TakeSnapshot(ctx, req.(*TakeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/RestoreSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceSnapshot_DiffSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceSnapshotServer).DiffSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceSnapshot/DiffSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceSnapshotServer).DiffSnapshots(ctx, req.(*DiffSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceSnapshot_ServiceDesc is the grpc.ServiceDesc for DeviceSnapshot service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceSnapshot_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceSnapshot",
+	HandlerType: (*DeviceSnapshotServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TakeSnapshot",
+			Handler:    _DeviceSnapshot_TakeSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _DeviceSnapshot_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _DeviceSnapshot_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DiffSnapshots",
+			Handler:    _DeviceSnapshot_DiffSnapshots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// SramBackupsClient is the client API for SramBackups service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SramBackupsClient interface {
+	// list stored SRAM backups, most recent first:
+	ListSramBackups(ctx context.Context, in *ListSramBackupsRequest, opts ...grpc.CallOption) (*ListSramBackupsResponse, error)
+	// write a stored SRAM backup to the device:
+	RestoreSramBackup(ctx context.Context, in *RestoreSramBackupRequest, opts ...grpc.CallOption) (*RestoreSramBackupResponse, error)
+}
+
+type sramBackupsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSramBackupsClient(cc grpc.ClientConnInterface) SramBackupsClient {
+	return &sramBackupsClient{cc}
+}
+
+func (c *sramBackupsClient) ListSramBackups(ctx context.Context, in *ListSramBackupsRequest, opts ...grpc.CallOption) (*ListSramBackupsResponse, error) {
+	out := new(ListSramBackupsResponse)
+	err := c.cc.Invoke(ctx, "/SramBackups/ListSramBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sramBackupsClient) RestoreSramBackup(ctx context.Context, in *RestoreSramBackupRequest, opts ...grpc.CallOption) (*RestoreSramBackupResponse, error) {
+	out := new(RestoreSramBackupResponse)
+	err := c.cc.Invoke(ctx, "/SramBackups/RestoreSramBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SramBackupsServer is the server API for SramBackups service.
+// All implementations must embed UnimplementedSramBackupsServer
+// for forward compatibility
+type SramBackupsServer interface {
+	// list stored SRAM backups, most recent first:
+	ListSramBackups(context.Context, *ListSramBackupsRequest) (*ListSramBackupsResponse, error)
+	// write a stored SRAM backup to the device:
+	RestoreSramBackup(context.Context, *RestoreSramBackupRequest) (*RestoreSramBackupResponse, error)
+	mustEmbedUnimplementedSramBackupsServer()
+}
+
+// UnimplementedSramBackupsServer must be embedded to have forward compatible implementations.
+type UnimplementedSramBackupsServer struct {
+}
+
+func (UnimplementedSramBackupsServer) ListSramBackups(context.Context, *ListSramBackupsRequest) (*ListSramBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSramBackups not implemented")
+}
+func (UnimplementedSramBackupsServer) RestoreSramBackup(context.Context, *RestoreSramBackupRequest) (*RestoreSramBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSramBackup not implemented")
+}
+func (UnimplementedSramBackupsServer) mustEmbedUnimplementedSramBackupsServer() {}
+
+// UnsafeSramBackupsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SramBackupsServer will
+// result in compilation errors.
+type UnsafeSramBackupsServer interface {
+	mustEmbedUnimplementedSramBackupsServer()
+}
+
+func RegisterSramBackupsServer(s grpc.ServiceRegistrar, srv SramBackupsServer) {
+	s.RegisterService(&SramBackups_ServiceDesc, srv)
+}
+
+func _SramBackups_ListSramBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSramBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SramBackupsServer).ListSramBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SramBackups/ListSramBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SramBackupsServer).ListSramBackups(ctx, req.(*ListSramBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SramBackups_RestoreSramBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSramBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SramBackupsServer).RestoreSramBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SramBackups/RestoreSramBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SramBackupsServer).RestoreSramBackup(ctx, req.(*RestoreSramBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SramBackups_ServiceDesc is the grpc.ServiceDesc for SramBackups service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SramBackups_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "SramBackups",
+	HandlerType: (*SramBackupsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSramBackups",
+			Handler:    _SramBackups_ListSramBackups_Handler,
+		},
+		{
+			MethodName: "RestoreSramBackup",
+			Handler:    _SramBackups_RestoreSramBackup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// MemorySearchClient is the client API for MemorySearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemorySearchClient interface {
+	// read a region of memory and start a search with every value in it as a candidate:
+	StartSearch(ctx context.Context, in *StartSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// read the region again and keep only the candidates matching a predicate:
+	RefineSearch(ctx context.Context, in *RefineSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// page through the candidates of a search:
+	GetSearchResults(ctx context.Context, in *GetSearchResultsRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// discard a search:
+	EndSearch(ctx context.Context, in *EndSearchRequest, opts ...grpc.CallOption) (*EndSearchResponse, error)
+}
+
+type memorySearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemorySearchClient(cc grpc.ClientConnInterface) MemorySearchClient {
+	return &memorySearchClient{cc}
+}
+
+func (c *memorySearchClient) StartSearch(ctx context.Context, in *StartSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/MemorySearch/StartSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memorySearchClient) RefineSearch(ctx context.Context, in *RefineSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/MemorySearch/RefineSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memorySearchClient) GetSearchResults(ctx context.Context, in *GetSearchResultsRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/MemorySearch/GetSearchResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memorySearchClient) EndSearch(ctx context.Context, in *EndSearchRequest, opts ...grpc.CallOption) (*EndSearchResponse, error) {
+	out := new(EndSearchResponse)
+	err := c.cc.Invoke(ctx, "/MemorySearch/EndSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemorySearchServer is the server API for MemorySearch service.
+// All implementations must embed UnimplementedMemorySearchServer
+// for forward compatibility
+type MemorySearchServer interface {
+	// read a region of memory and start a search with every value in it as a candidate:
+	StartSearch(context.Context, *StartSearchRequest) (*SearchResponse, error)
+	// read the region again and keep only the candidates matching a predicate:
+	RefineSearch(context.Context, *RefineSearchRequest) (*SearchResponse, error)
+	// page through the candidates of a search:
+	GetSearchResults(context.Context, *GetSearchResultsRequest) (*SearchResponse, error)
+	// discard a search:
+	EndSearch(context.Context, *EndSearchRequest) (*EndSearchResponse, error)
+	mustEmbedUnimplementedMemorySearchServer()
+}
+
+// UnimplementedMemorySearchServer must be embedded to have forward compatible implementations.
+type UnimplementedMemorySearchServer struct {
+}
+
+func (UnimplementedMemorySearchServer) StartSearch(context.Context, *StartSearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSearch not implemented")
+}
+func (UnimplementedMemorySearchServer) RefineSearch(context.Context, *RefineSearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefineSearch not implemented")
+}
+func (UnimplementedMemorySearchServer) GetSearchResults(context.Context, *GetSearchResultsRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchResults not implemented")
+}
+func (UnimplementedMemorySearchServer) EndSearch(context.Context, *EndSearchRequest) (*EndSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSearch not implemented")
+}
+func (UnimplementedMemorySearchServer) mustEmbedUnimplementedMemorySearchServer() {}
+
+// UnsafeMemorySearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemorySearchServer will
+// result in compilation errors.
+type UnsafeMemorySearchServer interface {
+	mustEmbedUnimplementedMemorySearchServer()
+}
+
+func RegisterMemorySearchServer(s grpc.ServiceRegistrar, srv MemorySearchServer) {
+	s.RegisterService(&MemorySearch_ServiceDesc, srv)
+}
+
+func _MemorySearch_StartSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemorySearchServer).StartSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MemorySearch/StartSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemorySearchServer).StartSearch(ctx, req.(*StartSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemorySearch_RefineSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefineSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemorySearchServer).RefineSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MemorySearch/RefineSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemorySearchServer).RefineSearch(ctx, req.(*RefineSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemorySearch_GetSearchResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemorySearchServer).GetSearchResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MemorySearch/GetSearchResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemorySearchServer).GetSearchResults(ctx, req.(*GetSearchResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemorySearch_EndSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemorySearchServer).EndSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MemorySearch/EndSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemorySearchServer).EndSearch(ctx, req.(*EndSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemorySearch_ServiceDesc is the grpc.ServiceDesc for MemorySearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemorySearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "MemorySearch",
+	HandlerType: (*MemorySearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartSearch",
+			Handler:    _MemorySearch_StartSearch_Handler,
+		},
+		{
+			MethodName: "RefineSearch",
+			Handler:    _MemorySearch_RefineSearch_Handler,
+		},
+		{
+			MethodName: "GetSearchResults",
+			Handler:    _MemorySearch_GetSearchResults_Handler,
+		},
+		{
+			MethodName: "EndSearch",
+			Handler:    _MemorySearch_EndSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// DeviceCheatsClient is the client API for DeviceCheats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceCheatsClient interface {
+	// decode a cheat code and add it to the device's cheats:
+	AddCheat(ctx context.Context, in *AddCheatRequest, opts ...grpc.CallOption) (*AddCheatResponse, error)
+	// list the device's cheats:
+	ListCheats(ctx context.Context, in *ListCheatsRequest, opts ...grpc.CallOption) (*ListCheatsResponse, error)
+	// enable or disable one of the device's cheats:
+	SetCheatEnabled(ctx context.Context, in *SetCheatEnabledRequest, opts ...grpc.CallOption) (*SetCheatEnabledResponse, error)
+	// disable and remove one of the device's cheats:
+	RemoveCheat(ctx context.Context, in *RemoveCheatRequest, opts ...grpc.CallOption) (*RemoveCheatResponse, error)
+}
+
+type deviceCheatsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceCheatsClient(cc grpc.ClientConnInterface) DeviceCheatsClient {
+	return &deviceCheatsClient{cc}
+}
+
+func (c *deviceCheatsClient) AddCheat(ctx context.Context, in *AddCheatRequest, opts ...grpc.CallOption) (*AddCheatResponse, error) {
+	out := new(AddCheatResponse)
+	err := c.cc.Invoke(ctx, "/DeviceCheats/AddCheat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceCheatsClient) ListCheats(ctx context.Context, in *ListCheatsRequest, opts ...grpc.CallOption) (*ListCheatsResponse, error) {
+	out := new(ListCheatsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceCheats/ListCheats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceCheatsClient) SetCheatEnabled(ctx context.Context, in *SetCheatEnabledRequest, opts ...grpc.CallOption) (*SetCheatEnabledResponse, error) {
+	out := new(SetCheatEnabledResponse)
+	err := c.cc.Invoke(ctx, "/DeviceCheats/SetCheatEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceCheatsClient) RemoveCheat(ctx context.Context, in *RemoveCheatRequest, opts ...grpc.CallOption) (*RemoveCheatResponse, error) {
+	out := new(RemoveCheatResponse)
+	err := c.cc.Invoke(ctx, "/DeviceCheats/RemoveCheat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceCheatsServer is the server API for DeviceCheats service.
+// All implementations must embed UnimplementedDeviceCheatsServer
+// for forward compatibility
+type DeviceCheatsServer interface {
+	// decode a cheat code and add it to the device's cheats:
+	AddCheat(context.Context, *AddCheatRequest) (*AddCheatResponse, error)
+	// list the device's cheats:
+	ListCheats(context.Context, *ListCheatsRequest) (*ListCheatsResponse, error)
+	// enable or disable one of the device's cheats:
+	SetCheatEnabled(context.Context, *SetCheatEnabledRequest) (*SetCheatEnabledResponse, error)
+	// disable and remove one of the device's cheats:
+	RemoveCheat(context.Context, *RemoveCheatRequest) (*RemoveCheatResponse, error)
+	mustEmbedUnimplementedDeviceCheatsServer()
+}
+
+// UnimplementedDeviceCheatsServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceCheatsServer struct {
+}
+
+func (UnimplementedDeviceCheatsServer) AddCheat(context.Context, *AddCheatRequest) (*AddCheatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCheat not implemented")
+}
+func (UnimplementedDeviceCheatsServer) ListCheats(context.Context, *ListCheatsRequest) (*ListCheatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheats not implemented")
+}
+func (UnimplementedDeviceCheatsServer) SetCheatEnabled(context.Context, *SetCheatEnabledRequest) (*SetCheatEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCheatEnabled not implemented")
+}
+func (UnimplementedDeviceCheatsServer) RemoveCheat(context.Context, *RemoveCheatRequest) (*RemoveCheatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCheat not implemented")
+}
+func (UnimplementedDeviceCheatsServer) mustEmbedUnimplementedDeviceCheatsServer() {}
+
+// UnsafeDeviceCheatsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceCheatsServer will
+// result in compilation errors.
+type UnsafeDeviceCheatsServer interface {
+	mustEmbedUnimplementedDeviceCheatsServer()
+}
+
+func RegisterDeviceCheatsServer(s grpc.ServiceRegistrar, srv DeviceCheatsServer) {
+	s.RegisterService(&DeviceCheats_ServiceDesc, srv)
+}
+
+func _DeviceCheats_AddCheat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCheatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceCheatsServer).AddCheat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceCheats/AddCheat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceCheatsServer).AddCheat(ctx, req.(*AddCheatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceCheats_ListCheats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceCheatsServer).ListCheats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceCheats/ListCheats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceCheatsServer).ListCheats(ctx, req.(*ListCheatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceCheats_SetCheatEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCheatEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceCheatsServer).SetCheatEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceCheats/SetCheatEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceCheatsServer).SetCheatEnabled(ctx, req.(*SetCheatEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceCheats_RemoveCheat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCheatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceCheatsServer).RemoveCheat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceCheats/RemoveCheat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceCheatsServer).RemoveCheat(ctx, req.(*RemoveCheatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceCheats_ServiceDesc is the grpc.ServiceDesc for DeviceCheats service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceCheats_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceCheats",
+	HandlerType: (*DeviceCheatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCheat",
+			Handler:    _DeviceCheats_AddCheat_Handler,
+		},
+		{
+			MethodName: "ListCheats",
+			Handler:    _DeviceCheats_ListCheats_Handler,
+		},
+		{
+			MethodName: "SetCheatEnabled",
+			Handler:    _DeviceCheats_SetCheatEnabled_Handler,
+		},
+		{
+			MethodName: "RemoveCheat",
+			Handler:    _DeviceCheats_RemoveCheat_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// SymbolsClient is the client API for Symbols service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SymbolsClient interface {
+	// load a symbol file for a device or a ROM:
+	LoadSymbols(ctx context.Context, in *LoadSymbolsRequest, opts ...grpc.CallOption) (*LoadSymbolsResponse, error)
+	// unload the symbol file loaded for a device or a ROM:
+	UnloadSymbols(ctx context.Context, in *UnloadSymbolsRequest, opts ...grpc.CallOption) (*UnloadSymbolsResponse, error)
+	// resolve a label expression to an address:
+	ResolveLabel(ctx context.Context, in *ResolveLabelRequest, opts ...grpc.CallOption) (*ResolveLabelResponse, error)
+}
+
+type symbolsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSymbolsClient(cc grpc.ClientConnInterface) SymbolsClient {
+	return &symbolsClient{cc}
+}
+
+func (c *symbolsClient) LoadSymbols(ctx context.Context, in *LoadSymbolsRequest, opts ...grpc.CallOption) (*LoadSymbolsResponse, error) {
+	out := new(LoadSymbolsResponse)
+	err := c.cc.Invoke(ctx, "/Symbols/LoadSymbols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsClient) UnloadSymbols(ctx context.Context, in *UnloadSymbolsRequest, opts ...grpc.CallOption) (*UnloadSymbolsResponse, error) {
+	out := new(UnloadSymbolsResponse)
+	err := c.cc.Invoke(ctx, "/Symbols/UnloadSymbols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsClient) ResolveLabel(ctx context.Context, in *ResolveLabelRequest, opts ...grpc.CallOption) (*ResolveLabelResponse, error) {
+	out := new(ResolveLabelResponse)
+	err := c.cc.Invoke(ctx, "/Symbols/ResolveLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SymbolsServer is the server API for Symbols service.
+// All implementations must embed UnimplementedSymbolsServer
+// for forward compatibility
+type SymbolsServer interface {
+	// load a symbol file for a device or a ROM:
+	LoadSymbols(context.Context, *LoadSymbolsRequest) (*LoadSymbolsResponse, error)
+	// unload the symbol file loaded for a device or a ROM:
+	UnloadSymbols(context.Context, *UnloadSymbolsRequest) (*UnloadSymbolsResponse, error)
+	// resolve a label expression to an address:
+	ResolveLabel(context.Context, *ResolveLabelRequest) (*ResolveLabelResponse, error)
+	mustEmbedUnimplementedSymbolsServer()
+}
+
+// UnimplementedSymbolsServer must be embedded to have forward compatible implementations.
+type UnimplementedSymbolsServer struct {
+}
+
+func (UnimplementedSymbolsServer) LoadSymbols(context.Context, *LoadSymbolsRequest) (*LoadSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadSymbols not implemented")
+}
+func (UnimplementedSymbolsServer) UnloadSymbols(context.Context, *UnloadSymbolsRequest) (*UnloadSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadSymbols not implemented")
+}
+func (UnimplementedSymbolsServer) ResolveLabel(context.Context, *ResolveLabelRequest) (*ResolveLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveLabel not implemented")
+}
+func (UnimplementedSymbolsServer) mustEmbedUnimplementedSymbolsServer() {}
+
+// UnsafeSymbolsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SymbolsServer will
+// result in compilation errors.
+type UnsafeSymbolsServer interface {
+	mustEmbedUnimplementedSymbolsServer()
+}
+
+func RegisterSymbolsServer(s grpc.ServiceRegistrar, srv SymbolsServer) {
+	s.RegisterService(&Symbols_ServiceDesc, srv)
+}
+
+func _Symbols_LoadSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServer).LoadSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Symbols/LoadSymbols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServer).LoadSymbols(ctx, req.(*LoadSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Symbols_UnloadSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnloadSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServer).UnloadSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Symbols/UnloadSymbols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServer).UnloadSymbols(ctx, req.(*UnloadSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Symbols_ResolveLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServer).ResolveLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Symbols/ResolveLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServer).ResolveLabel(ctx, req.(*ResolveLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Symbols_ServiceDesc is the grpc.ServiceDesc for Symbols service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Symbols_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Symbols",
+	HandlerType: (*SymbolsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoadSymbols",
+			Handler:    _Symbols_LoadSymbols_Handler,
+		},
+		{
+			MethodName: "UnloadSymbols",
+			Handler:    _Symbols_UnloadSymbols_Handler,
+		},
+		{
+			MethodName: "ResolveLabel",
+			Handler:    _Symbols_ResolveLabel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sni.proto",
+}
+
+// DevicePatchClient is the client API for DevicePatch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DevicePatchClient interface {
+	// apply a patch live to ROM or to a file:
+	ApplyPatch(ctx context.Context, in *ApplyPatchRequest, opts ...grpc.CallOption) (*ApplyPatchResponse, error)
+}
+
+type devicePatchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDevicePatchClient(cc grpc.ClientConnInterface) DevicePatchClient {
+	return &devicePatchClient{cc}
+}
+
+func (c *devicePatchClient) ApplyPatch(ctx context.Context, in *ApplyPatchRequest, opts ...grpc.CallOption) (*ApplyPatchResponse, error) {
+	out := new(ApplyPatchResponse)
+	err := c.cc.Invoke(ctx, "/DevicePatch/ApplyPatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DevicePatchServer is the server API for DevicePatch service.
+// All implementations must embed UnimplementedDevicePatchServer
+// for forward compatibility
+type DevicePatchServer interface {
+	// apply a patch live to ROM or to a file:
+	ApplyPatch(context.Context, *ApplyPatchRequest) (*ApplyPatchResponse, error)
+	mustEmbedUnimplementedDevicePatchServer()
+}
+
+// UnimplementedDevicePatchServer must be embedded to have forward compatible implementations.
+type UnimplementedDevicePatchServer struct {
+}
+
+func (UnimplementedDevicePatchServer) ApplyPatch(context.Context, *ApplyPatchRequest) (*ApplyPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPatch not implemented")
+}
+func (UnimplementedDevicePatchServer) mustEmbedUnimplementedDevicePatchServer() {}
+
+// UnsafeDevicePatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DevicePatchServer will
+// result in compilation errors.
+type UnsafeDevicePatchServer interface {
+	mustEmbedUnimplementedDevicePatchServer()
+}
+
+func RegisterDevicePatchServer(s grpc.ServiceRegistrar, srv DevicePatchServer) {
+	s.RegisterService(&DevicePatch_ServiceDesc, srv)
+}
+
+func _DevicePatch_ApplyPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicePatchServer).ApplyPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DevicePatch/ApplyPatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicePatchServer).ApplyPatch(ctx, req.(*ApplyPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DevicePatch_ServiceDesc is the grpc.ServiceDesc for DevicePatch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DevicePatch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DevicePatch",
+	HandlerType: (*DevicePatchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyPatch",
+			Handler:    _DevicePatch_ApplyPatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
 
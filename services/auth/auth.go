@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"sni/cmd/sni/config"
+	"strings"
+	"sync"
+)
+
+// Permission is a set of operations an API token grants access to.
+type Permission uint8
+
+const (
+	// PermissionRead allows reading memory and fetching device information.
+	PermissionRead Permission = 1 << iota
+	// PermissionWrite allows writing memory.
+	PermissionWrite
+	// PermissionFilesystem allows reading and changing files on the device.
+	PermissionFilesystem
+	// PermissionControl allows resetting, pausing and otherwise controlling the device.
+	PermissionControl
+
+	PermissionNone Permission = 0
+	PermissionAll             = PermissionRead | PermissionWrite | PermissionFilesystem | PermissionControl
+)
+
+var permissionNames = []struct {
+	name       string
+	permission Permission
+}{
+	{"read", PermissionRead},
+	{"write", PermissionWrite},
+	{"filesystem", PermissionFilesystem},
+	{"control", PermissionControl},
+}
+
+func (p Permission) String() string {
+	if p == PermissionNone {
+		return "none"
+	}
+	if p == PermissionAll {
+		return "*"
+	}
+
+	names := make([]string, 0, len(permissionNames))
+	for _, n := range permissionNames {
+		if p&n.permission != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// ParsePermission parses a `+`-delimited list of permission names, e.g. `read+write`, or `*` for all permissions.
+func ParsePermission(s string) (p Permission, err error) {
+	for _, name := range strings.Split(s, "+") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "*" {
+			p |= PermissionAll
+			continue
+		}
+
+		found := false
+		for _, n := range permissionNames {
+			if name == n.name {
+				p |= n.permission
+				found = true
+				break
+			}
+		}
+		if !found {
+			return PermissionNone, fmt.Errorf("auth: unknown permission '%s'", name)
+		}
+	}
+	return
+}
+
+var (
+	// ErrUnauthenticated is returned when authentication is enabled and no valid token is presented.
+	ErrUnauthenticated = errors.New("auth: missing or invalid API token")
+	// ErrPermissionDenied is returned when a valid token lacks a required permission.
+	ErrPermissionDenied = errors.New("auth: API token lacks required permission")
+)
+
+type token struct {
+	value      []byte
+	permission Permission
+}
+
+var (
+	tokensMu     sync.Mutex
+	tokensConfig string
+	tokens       []token
+)
+
+// parseTokens parses a comma-delimited list of `token=permissions` pairs, e.g. `abc123=read,def456=read+write`.
+func parseTokens(s string) (parsed []token, err error) {
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		value, permStr, ok := strings.Cut(pair, "=")
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("auth: token entry must be of the form token=permissions")
+		}
+
+		var p Permission
+		p, err = ParsePermission(permStr)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, token{value: []byte(value), permission: p})
+	}
+	return
+}
+
+// configuredTokens returns the tokens in the `auth_tokens` setting, re-parsing them only when the setting changed.
+// An invalid setting is logged and grants no access at all so that a typo does not disable authentication.
+func configuredTokens() (enabled bool, configured []token) {
+	s := config.Config.GetString("auth_tokens")
+
+	tokensMu.Lock()
+	defer tokensMu.Unlock()
+
+	if s != tokensConfig {
+		tokensConfig = s
+		var err error
+		tokens, err = parseTokens(s)
+		if err != nil {
+			log.Printf("auth: invalid auth_tokens setting: %v; denying all requests\n", err)
+			tokens = []token{}
+		}
+	}
+
+	return strings.TrimSpace(s) != "", tokens
+}
+
+// Enabled returns true if API tokens are configured and must be presented by clients.
+func Enabled() bool {
+	enabled, _ := configuredTokens()
+	return enabled
+}
+
+// PermissionFor returns the permissions granted to the given token. When authentication is disabled, all
+// permissions are granted to all clients.
+func PermissionFor(value string) (Permission, error) {
+	enabled, configured := configuredTokens()
+	if !enabled {
+		return PermissionAll, nil
+	}
+
+	v := []byte(value)
+	for _, t := range configured {
+		if subtle.ConstantTimeCompare(t.value, v) == 1 {
+			return t.permission, nil
+		}
+	}
+	return PermissionNone, ErrUnauthenticated
+}
+
+// Check returns nil if the given token grants all the required permissions.
+func Check(value string, required Permission) error {
+	p, err := PermissionFor(value)
+	if err != nil {
+		return err
+	}
+	if p&required != required {
+		return fmt.Errorf("%w: requires '%s' but has '%s'", ErrPermissionDenied, required, p)
+	}
+	return nil
+}
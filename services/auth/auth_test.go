@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"errors"
+	"sni/cmd/sni/config"
+	"testing"
+)
+
+func setTokens(t *testing.T, s string) {
+	config.Config.Set("auth_tokens", s)
+	t.Cleanup(func() { config.Config.Set("auth_tokens", "") })
+}
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Permission
+		wantErr bool
+	}{
+		{"read", PermissionRead, false},
+		{"read+write", PermissionRead | PermissionWrite, false},
+		{" Filesystem + control ", PermissionFilesystem | PermissionControl, false},
+		{"*", PermissionAll, false},
+		{"read+admin", PermissionNone, true},
+		{"", PermissionNone, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParsePermission(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePermission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePermission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck_Disabled(t *testing.T) {
+	setTokens(t, "")
+
+	if Enabled() {
+		t.Fatal("expected authentication to be disabled")
+	}
+	if err := Check("", PermissionAll); err != nil {
+		t.Fatalf("expected all permissions when disabled; got %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	setTokens(t, "reader=read, writer=read+write,admin=*")
+
+	tests := []struct {
+		token    string
+		required Permission
+		wantErr  error
+	}{
+		{"reader", PermissionRead, nil},
+		{"reader", PermissionNone, nil},
+		{"reader", PermissionWrite, ErrPermissionDenied},
+		{"writer", PermissionRead | PermissionWrite, nil},
+		{"writer", PermissionControl, ErrPermissionDenied},
+		{"admin", PermissionAll, nil},
+		{"", PermissionNone, ErrUnauthenticated},
+		{"read", PermissionRead, ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		err := Check(tt.token, tt.required)
+		if tt.wantErr == nil && err != nil {
+			t.Errorf("Check(%q, %v) = %v, want nil", tt.token, tt.required, err)
+		} else if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+			t.Errorf("Check(%q, %v) = %v, want %v", tt.token, tt.required, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheck_InvalidSettingDeniesAll(t *testing.T) {
+	setTokens(t, "admin=*,oops")
+
+	if err := Check("admin", PermissionNone); !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("expected an invalid setting to deny all tokens; got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package grpcimpl
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sni/services/auth"
+	"strings"
+)
+
+// authMetadataKey is the request metadata key clients send their API token in, as `Bearer <token>`:
+const authMetadataKey = "authorization"
+
+// methodPermissions lists the permissions required to call each method when authentication is enabled. Methods not
+// listed here require all permissions.
+var methodPermissions = map[string]auth.Permission{
+	"/Devices/ListDevices":  auth.PermissionNone,
+	"/Devices/WatchDevices": auth.PermissionNone,
+
+	"/DeviceControl/ResetSystem":           auth.PermissionControl,
+	"/DeviceControl/ResetToMenu":           auth.PermissionControl,
+	"/DeviceControl/PauseUnpauseEmulation": auth.PermissionControl,
+	"/DeviceControl/PauseToggleEmulation":  auth.PermissionControl,
+
+	"/DeviceMemory/MappingDetect": auth.PermissionRead,
+	"/DeviceMemory/SingleRead":    auth.PermissionRead,
+	"/DeviceMemory/SingleWrite":   auth.PermissionWrite,
+	"/DeviceMemory/MultiRead":     auth.PermissionRead,
+	"/DeviceMemory/MultiWrite":    auth.PermissionWrite,
+	"/DeviceMemory/StreamRead":    auth.PermissionRead,
+	"/DeviceMemory/StreamWrite":   auth.PermissionWrite,
+	"/DeviceMemory/WatchMemory":   auth.PermissionRead,
+	"/DeviceMemory/ReadTyped":     auth.PermissionRead,
+	"/DeviceMemory/RomInfo":       auth.PermissionRead,
+
+	"/DeviceLease/AcquireLease": auth.PermissionWrite,
+	"/DeviceLease/ReleaseLease": auth.PermissionWrite,
+
+	"/DeviceSnapshot/TakeSnapshot":    auth.PermissionRead,
+	"/DeviceSnapshot/RestoreSnapshot": auth.PermissionWrite,
+	"/DeviceSnapshot/ListSnapshots":   auth.PermissionRead,
+	"/DeviceSnapshot/DiffSnapshots":   auth.PermissionRead,
+
+	"/SramBackups/ListSramBackups":   auth.PermissionRead,
+	"/SramBackups/RestoreSramBackup": auth.PermissionWrite,
+
+	"/MemorySearch/StartSearch":      auth.PermissionRead,
+	"/MemorySearch/RefineSearch":     auth.PermissionRead,
+	"/MemorySearch/GetSearchResults": auth.PermissionRead,
+	"/MemorySearch/EndSearch":        auth.PermissionRead,
+
+	"/DeviceCheats/AddCheat":        auth.PermissionWrite,
+	"/DeviceCheats/ListCheats":      auth.PermissionRead,
+	"/DeviceCheats/SetCheatEnabled": auth.PermissionWrite,
+	"/DeviceCheats/RemoveCheat":     auth.PermissionWrite,
+
+	// loading symbols changes how every client's labels resolve:
+	"/Symbols/LoadSymbols":   auth.PermissionWrite,
+	"/Symbols/UnloadSymbols": auth.PermissionWrite,
+	"/Symbols/ResolveLabel":  auth.PermissionRead,
+
+	"/DeviceFilesystem/ReadDirectory": auth.PermissionFilesystem,
+	"/DeviceFilesystem/MakeDirectory": auth.PermissionFilesystem,
+	"/DeviceFilesystem/RemoveFile":    auth.PermissionFilesystem,
+	"/DeviceFilesystem/RenameFile":    auth.PermissionFilesystem,
+	"/DeviceFilesystem/PutFile":       auth.PermissionFilesystem,
+	"/DeviceFilesystem/GetFile":       auth.PermissionFilesystem,
+	"/DeviceFilesystem/BootFile":      auth.PermissionFilesystem | auth.PermissionControl,
+
+	"/DeviceInfo/FetchFields": auth.PermissionRead,
+
+	// patching a file additionally requires PermissionFilesystem, checked by the method itself:
+	"/DevicePatch/ApplyPatch": auth.PermissionWrite,
+
+	// NWA commands can do anything the emulator allows:
+	"/DeviceNWA/NWACommand": auth.PermissionAll,
+
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      auth.PermissionNone,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": auth.PermissionNone,
+}
+
+// authorize checks that the API token sent in the request metadata grants the permissions required by the method.
+func authorize(ctx context.Context, fullMethod string) error {
+	required, ok := methodPermissions[fullMethod]
+	if !ok {
+		required = auth.PermissionAll
+	}
+
+	return authorizePermission(ctx, required)
+}
+
+// authorizePermission checks that the API token sent in the request metadata grants the required permissions. It is
+// used directly by methods whose required permissions depend on the request.
+func authorizePermission(ctx context.Context, required auth.Permission) error {
+	if !auth.Enabled() {
+		return nil
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authMetadataKey); len(values) > 0 {
+			token = strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+		}
+	}
+
+	err := auth.Check(token, required)
+	if errors.Is(err, auth.ErrUnauthenticated) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+func authInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	if err = authorize(ctx, info.FullMethod); err != nil {
+		return
+	}
+	return handler(ctx, req)
+}
+
+func authStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	if err = authorize(ss.Context(), info.FullMethod); err != nil {
+		return
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,21 @@
+package grpcimpl
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestMethodPermissions_CoverAllMethods(t *testing.T) {
+	s := grpc.NewServer()
+	registerServices(s)
+
+	for service, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := "/" + service + "/" + method.Name
+			if _, ok := methodPermissions[fullMethod]; !ok {
+				t.Errorf("%s is missing from methodPermissions", fullMethod)
+			}
+		}
+	}
+}
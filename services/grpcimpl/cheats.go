@@ -0,0 +1,129 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/cheats"
+	"sni/protos/sni"
+)
+
+type DeviceCheatsService struct {
+	sni.UnimplementedDeviceCheatsServer
+}
+
+func cheatToProto(c *cheats.Cheat) *sni.Cheat {
+	return &sni.Cheat{
+		Id:            c.ID,
+		Name:          c.Name,
+		Code:          c.Code.String(),
+		Format:        sni.CheatFormat(c.Format),
+		Address:       c.Address,
+		Value:         uint32(c.Value),
+		DeviceAddress: c.PakAddress,
+		MemoryMapping: c.MemoryMapping,
+		RomPatch:      c.IsRomPatch(),
+		Enabled:       c.Enabled,
+	}
+}
+
+// cheatsDevice finds the device to apply cheats to:
+func cheatsDevice(uriString string) (device devices.AutoCloseableDevice, gerr error) {
+	uri, err := url.Parse(uriString)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	return
+}
+
+func (s *DeviceCheatsService) AddCheat(gctx context.Context, request *sni.AddCheatRequest) (grsp *sni.AddCheatResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = cheatsDevice(request.GetUri())
+	if gerr != nil {
+		return
+	}
+
+	var cheat cheats.Cheat
+	cheat, gerr = cheats.Add(
+		gctx,
+		device,
+		request.GetUri(),
+		request.GetCode(),
+		request.GetName(),
+		request.GetMemoryMapping(),
+		request.GetEnabled(),
+	)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.AddCheatResponse{
+		Uri:   request.GetUri(),
+		Cheat: cheatToProto(&cheat),
+	}
+	return
+}
+
+func (s *DeviceCheatsService) ListCheats(_ context.Context, request *sni.ListCheatsRequest) (grsp *sni.ListCheatsResponse, gerr error) {
+	list := cheats.List(request.GetUri())
+
+	grsp = &sni.ListCheatsResponse{
+		Uri:    request.GetUri(),
+		Cheats: make([]*sni.Cheat, 0, len(list)),
+	}
+	for i := range list {
+		grsp.Cheats = append(grsp.Cheats, cheatToProto(&list[i]))
+	}
+	return
+}
+
+func (s *DeviceCheatsService) SetCheatEnabled(gctx context.Context, request *sni.SetCheatEnabledRequest) (grsp *sni.SetCheatEnabledResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = cheatsDevice(request.GetUri())
+	if gerr != nil {
+		return
+	}
+
+	var cheat cheats.Cheat
+	cheat, gerr = cheats.SetEnabled(gctx, device, request.GetUri(), request.GetId(), request.GetEnabled())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.SetCheatEnabledResponse{
+		Uri:   request.GetUri(),
+		Cheat: cheatToProto(&cheat),
+	}
+	return
+}
+
+func (s *DeviceCheatsService) RemoveCheat(gctx context.Context, request *sni.RemoveCheatRequest) (grsp *sni.RemoveCheatResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = cheatsDevice(request.GetUri())
+	if gerr != nil {
+		return
+	}
+
+	gerr = cheats.Remove(gctx, device, request.GetUri(), request.GetId())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RemoveCheatResponse{
+		Uri: request.GetUri(),
+	}
+	return
+}
@@ -22,29 +22,10 @@ type DevicesService struct {
 }
 
 func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRequest) (*sni.DevicesResponse, error) {
-	descriptors := make([]devices.DeviceDescriptor, 0, 10)
-	drivers := devices.Drivers()
-	for _, driver := range drivers {
-		d, err := driver.Driver.Detect()
-		if err != nil {
-			return nil, err
-		}
-		descriptors = append(descriptors, d...)
-	}
+	// detect through the devices package so that WatchDevices subscribers and the tray see the detection too:
+	descriptors := devices.DetectDevices()
 
-	var kindPredicate func(kind string) bool
-	if request.GetKinds() == nil {
-		kindPredicate = func(kind string) bool { return true }
-	} else {
-		kindPredicate = func(kind string) bool {
-			for _, k := range request.GetKinds() {
-				if kind == k {
-					return true
-				}
-			}
-			return false
-		}
-	}
+	kindPredicate := kindPredicateFor(request)
 
 	devs := make([]*sni.DevicesResponse_Device, 0, 10)
 	for _, descriptor := range descriptors {
@@ -52,18 +33,79 @@ func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRe
 			continue
 		}
 
-		devs = append(devs, &sni.DevicesResponse_Device{
-			Uri:                 descriptor.Uri.String(),
-			DisplayName:         descriptor.DisplayName,
-			Kind:                descriptor.Kind,
-			Capabilities:        descriptor.Capabilities,
-			DefaultAddressSpace: descriptor.DefaultAddressSpace,
-		})
+		devs = append(devs, deviceDescriptorToProto(&descriptor))
 	}
 
 	return &sni.DevicesResponse{Devices: devs}, nil
 }
 
+func (s *DevicesService) WatchDevices(request *sni.DevicesRequest, stream sni.Devices_WatchDevicesServer) error {
+	kindPredicate := kindPredicateFor(request)
+
+	first := true
+	for ev := range devices.WatchDevices(stream.Context()) {
+		events := make([]*sni.WatchDevicesResponse_Event, 0, len(ev.Events))
+		for _, event := range ev.Events {
+			if !kindPredicate(event.Descriptor.Kind) {
+				continue
+			}
+
+			events = append(events, &sni.WatchDevicesResponse_Event{
+				Type:   sni.DeviceEventType(event.Type),
+				Device: deviceDescriptorToProto(&event.Descriptor),
+			})
+		}
+
+		devs := make([]*sni.DevicesResponse_Device, 0, len(ev.Devices))
+		for _, descriptor := range ev.Devices {
+			if !kindPredicate(descriptor.Kind) {
+				continue
+			}
+
+			devs = append(devs, deviceDescriptorToProto(&descriptor))
+		}
+
+		// skip events only concerning devices of other kinds, but always send the first response:
+		if len(events) == 0 && !first {
+			continue
+		}
+		first = false
+
+		err := stream.Send(&sni.WatchDevicesResponse{Events: events, Devices: devs})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func kindPredicateFor(request *sni.DevicesRequest) func(kind string) bool {
+	if request.GetKinds() == nil {
+		return func(kind string) bool { return true }
+	}
+
+	return func(kind string) bool {
+		for _, k := range request.GetKinds() {
+			if kind == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func deviceDescriptorToProto(descriptor *devices.DeviceDescriptor) *sni.DevicesResponse_Device {
+	return &sni.DevicesResponse_Device{
+		Uri:                 descriptor.Uri.String(),
+		StableUri:           descriptor.StableUri.String(),
+		DisplayName:         descriptor.DisplayName,
+		Kind:                descriptor.Kind,
+		Capabilities:        descriptor.Capabilities,
+		DefaultAddressSpace: descriptor.DefaultAddressSpace,
+	}
+}
+
 func (s *DevicesService) MethodRequestString(method string, req interface{}) string {
 	if req == nil {
 		return "nil"
@@ -2,6 +2,7 @@ package grpcimpl
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net"
@@ -10,12 +11,15 @@ import (
 	"sni/protos/sni"
 	"sni/util"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 const fullMethodFormatter = "%32s"
@@ -23,6 +27,9 @@ const fullMethodFormatter = "%32s"
 var (
 	ListenHost string
 	GrpcServer *grpc.Server
+
+	// tlsConfig is nil when TLS is disabled:
+	tlsConfig *tls.Config
 )
 
 func StartGrpcServer() {
@@ -31,24 +38,46 @@ func StartGrpcServer() {
 
 	const maxMessageSize = 100 * 1024 * 1024 // 100 MB
 
-	// create gRPC server:
-	GrpcServer = grpc.NewServer(
-		grpc.ChainUnaryInterceptor(logTimingInterceptor),
-		grpc.ChainStreamInterceptor(reportErrorStreamInterceptor),
+	var err error
+	tlsConfig, err = loadTLSConfig()
+	if err != nil {
+		log.Fatalf("grpc: failed to load TLS configuration: %v", err)
+	}
+
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(logTimingInterceptor, authInterceptor, leaseTokenInterceptor),
+		grpc.ChainStreamInterceptor(reportErrorStreamInterceptor, authStreamInterceptor, leaseTokenStreamInterceptor),
 		grpc.MaxRecvMsgSize(maxMessageSize),
-	)
-	sni.RegisterDevicesServer(GrpcServer, &DevicesService{})
-	sni.RegisterDeviceMemoryServer(GrpcServer, &DeviceMemoryService{})
-	sni.RegisterDeviceControlServer(GrpcServer, &DeviceControlService{})
-	sni.RegisterDeviceFilesystemServer(GrpcServer, &DeviceFilesystem{})
-	sni.RegisterDeviceInfoServer(GrpcServer, &DeviceInfoService{})
-	sni.RegisterDeviceNWAServer(GrpcServer, &DeviceNWAService{})
-	reflection.Register(GrpcServer)
+	}
+	if tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	// create gRPC server:
+	GrpcServer = grpc.NewServer(options...)
+	registerServices(GrpcServer)
 
 	go serveGrpc()
 	go serveGrpcWeb()
 }
 
+func registerServices(s *grpc.Server) {
+	sni.RegisterDevicesServer(s, &DevicesService{})
+	sni.RegisterDeviceMemoryServer(s, &DeviceMemoryService{})
+	sni.RegisterDeviceControlServer(s, &DeviceControlService{})
+	sni.RegisterDeviceFilesystemServer(s, &DeviceFilesystem{})
+	sni.RegisterDeviceInfoServer(s, &DeviceInfoService{})
+	sni.RegisterDeviceNWAServer(s, &DeviceNWAService{})
+	sni.RegisterDeviceLeaseServer(s, &DeviceLeaseService{})
+	sni.RegisterDeviceSnapshotServer(s, &DeviceSnapshotService{})
+	sni.RegisterSramBackupsServer(s, &SramBackupsService{})
+	sni.RegisterMemorySearchServer(s, &MemorySearchService{})
+	sni.RegisterDeviceCheatsServer(s, &DeviceCheatsService{})
+	sni.RegisterSymbolsServer(s, &SymbolsService{})
+	sni.RegisterDevicePatchServer(s, &DevicePatchService{})
+	reflection.Register(s)
+}
+
 func serveGrpc() {
 	defer util.Recover()
 
@@ -86,7 +115,11 @@ func listenGrpc(listenAddr string) {
 		log.Fatalf("grpc: failed to listen: %v", err)
 	}
 
-	log.Printf("grpc: listening on %s\n", listenAddr)
+	if tlsConfig != nil {
+		log.Printf("grpc: listening on %s with TLS\n", listenAddr)
+	} else {
+		log.Printf("grpc: listening on %s\n", listenAddr)
+	}
 	if err := GrpcServer.Serve(lis); err != nil {
 		log.Fatalf("grpc: failed to serve: %v", err)
 	}
@@ -100,15 +133,27 @@ func serveGrpcWeb() {
 	wrappedGrpc := grpcweb.WrapServer(
 		GrpcServer,
 		grpcweb.WithWebsockets(true),
-		grpcweb.WithOriginFunc(func(origin string) bool { return true }),
-		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool { return true }),
+		grpcweb.WithOriginFunc(originAllowed),
+		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool { return originAllowed(req.Header.Get("Origin")) }),
 	)
 
 	//corsWrapper := wrappedGrpc
 	corsWrapper := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		rw.Header().Add("Access-Control-Allow-Origin", "*")
+		origin := req.Header.Get("Origin")
+		if origin != "" && !originAllowed(origin) {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if allowedOrigins() == nil {
+			rw.Header().Add("Access-Control-Allow-Origin", "*")
+		} else if origin != "" {
+			rw.Header().Add("Access-Control-Allow-Origin", origin)
+			rw.Header().Add("Vary", "Origin")
+		}
 		rw.Header().Add("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		rw.Header().Add("Access-Control-Allow-Headers", "*")
+		// the `*` wildcard does not cover the Authorization header:
+		rw.Header().Add("Access-Control-Allow-Headers", "*, Authorization")
 
 		if wrappedGrpc.IsGrpcWebSocketRequest(req) {
 			wrappedGrpc.HandleGrpcWebsocketRequest(rw, req)
@@ -119,6 +164,19 @@ func serveGrpcWeb() {
 			return
 		}
 
+		if req.URL.Path == "/metrics" && !config.Config.GetBool("metrics_disable") {
+			serveMetrics(rw, req)
+			return
+		}
+		if req.URL.Path == "/ca.pem" {
+			// let clients on other machines download the generated CA certificate to trust:
+			if caPem := readCACert(); caPem != nil {
+				rw.Header().Set("Content-Type", "application/x-pem-file")
+				_, _ = rw.Write(caPem)
+				return
+			}
+		}
+
 		rw.WriteHeader(http.StatusOK)
 		_, _ = rw.Write(make([]byte, 0))
 	})
@@ -131,6 +189,34 @@ func serveGrpcWeb() {
 	}
 }
 
+// allowedOrigins returns the origins listed in the `grpcweb_allowed_origins` setting or nil if all are allowed,
+// i.e. the setting is empty or contains `*`:
+func allowedOrigins() (origins []string) {
+	for _, origin := range strings.Split(config.Config.GetString("grpcweb_allowed_origins"), ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "*" {
+			return nil
+		}
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return
+}
+
+func originAllowed(origin string) bool {
+	origins := allowedOrigins()
+	if origins == nil {
+		return true
+	}
+	for _, o := range origins {
+		if strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
 func listenGrpcWeb(webListenAddr string, corsWrapper http.HandlerFunc) {
 	defer func() {
 		if pnk := recover(); pnk != nil {
@@ -162,8 +248,14 @@ func listenGrpcWeb(webListenAddr string, corsWrapper http.HandlerFunc) {
 		time.Sleep(time.Second)
 	}
 
-	log.Printf("grpcweb: listening on %s\n", webListenAddr)
-	err = http.Serve(lis, corsWrapper)
+	if tlsConfig != nil {
+		log.Printf("grpcweb: listening on %s with TLS\n", webListenAddr)
+		srv := &http.Server{Handler: corsWrapper, TLSConfig: tlsConfig.Clone()}
+		err = srv.ServeTLS(lis, "", "")
+	} else {
+		log.Printf("grpcweb: listening on %s\n", webListenAddr)
+		err = http.Serve(lis, corsWrapper)
+	}
 	log.Printf("grpcweb: exit listenHttp: %v\n", err)
 }
 
@@ -189,6 +281,7 @@ func logTimingInterceptor(
 
 	// stop timer:
 	tEnd := time.Now()
+	observeRequest(info.FullMethod, tEnd.Sub(tStart), err)
 
 	var reqStr, rspStr string
 	if err != nil || config.VerboseLogging {
@@ -232,7 +325,10 @@ func reportErrorStreamInterceptor(
 	}
 
 	log.Printf(fullMethodFormatter+": start stream from %s\n", info.FullMethod, streamSource)
+	grpcActiveStreams.WithLabelValues(info.FullMethod).Inc()
 	err = handler(srv, ss)
+	grpcActiveStreams.WithLabelValues(info.FullMethod).Dec()
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
 	if err != nil {
 		log.Printf(fullMethodFormatter+": end stream from %s; err=`%v`\n", info.FullMethod, streamSource, err)
 	} else {
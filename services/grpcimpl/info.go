@@ -4,8 +4,11 @@ import (
 	"context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"log"
 	"net/url"
 	"sni/devices"
+	"sni/devices/snes/gamedb"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
 )
 
@@ -40,6 +43,16 @@ func (d *DeviceInfoService) FetchFields(gctx context.Context, request *sni.Field
 		return nil, status.Error(codes.Internal, "values slice length did not match fields slice length")
 	}
 
+	// compute the ROM hashes and identify the game by reading the ROM since drivers do not provide them. failing
+	// that, the fields are left empty rather than failing the fields the driver did provide:
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err == nil {
+		if err = romhash.FillFields(gctx, device, request.GetUri(), request.Fields, values); err != nil {
+			log.Printf("grpc: %s: romhash: %v\n", request.GetUri(), err)
+		} else if err = gamedb.FillFields(gctx, device, request.GetUri(), request.Fields, values); err != nil {
+			log.Printf("grpc: %s: gamedb: %v\n", request.GetUri(), err)
+		}
+	}
+
 	grsp = &sni.FieldsResponse{
 		Uri:    request.Uri,
 		Fields: request.Fields,
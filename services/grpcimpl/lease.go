@@ -0,0 +1,120 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+	"time"
+)
+
+// leaseTokenMetadataKey is the request metadata key clients send their lease token in:
+const leaseTokenMetadataKey = "sni-lease-token"
+
+type DeviceLeaseService struct {
+	sni.UnimplementedDeviceLeaseServer
+}
+
+func (s *DeviceLeaseService) AcquireLease(gctx context.Context, request *sni.AcquireLeaseRequest) (grsp *sni.AcquireLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device devices.AutoCloseableDevice
+	_, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var token string
+	var expires time.Time
+	token, expires, gerr = device.AcquireLease(
+		gctx,
+		request.GetToken(),
+		time.Duration(request.GetDurationMilliseconds())*time.Millisecond,
+		request.GetWait(),
+	)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.AcquireLeaseResponse{
+		Uri:                       request.Uri,
+		Token:                     token,
+		ExpiresAtUnixMilliseconds: expires.UnixMilli(),
+	}
+
+	return
+}
+
+func (s *DeviceLeaseService) ReleaseLease(gctx context.Context, request *sni.ReleaseLeaseRequest) (grsp *sni.ReleaseLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device devices.AutoCloseableDevice
+	_, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	gerr = device.ReleaseLease(gctx, request.GetToken())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ReleaseLeaseResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+// leaseTokenContext carries the lease token from the request metadata, if any, into the context passed to devices.
+func leaseTokenContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	tokens := md.Get(leaseTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return ctx
+	}
+
+	return devices.WithLeaseToken(ctx, tokens[0])
+}
+
+func leaseTokenInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	return handler(leaseTokenContext(ctx), req)
+}
+
+func leaseTokenStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: leaseTokenContext(ss.Context())})
+}
+
+// contextServerStream overrides the context of a grpc.ServerStream:
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
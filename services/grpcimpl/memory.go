@@ -9,8 +9,13 @@ import (
 	"net/url"
 	"sni/devices"
 	"sni/devices/snes/mapping"
+	"sni/devices/snes/romhash"
+	"sni/devices/snes/schema"
+	"sni/devices/snes/symbols"
 	"sni/protos/sni"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type DeviceMemoryService struct {
@@ -65,6 +70,80 @@ func (s *DeviceMemoryService) MappingDetect(gctx context.Context, request *sni.D
 	return
 }
 
+func (s *DeviceMemoryService) RomInfo(gctx context.Context, request *sni.RomInfoRequest) (grsp *sni.RomInfoResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		gerr = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+	if request.RomHeader00FFB0 != nil && len(request.RomHeader00FFB0) < 0x30 {
+		gerr = status.Error(codes.InvalidArgument, "input ROM header must be at least $30 bytes")
+		return
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	// require ReadMemory capability if ROM header data is not provided or the ROM is to be summed:
+	if request.RomHeader00FFB0 == nil || request.SumRom {
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+	}
+
+	var memoryMapping sni.MemoryMapping
+	var confidence bool
+	var outHeaderBytes []byte
+	memoryMapping, confidence, outHeaderBytes, gerr = mapping.Detect(gctx, device, nil, request.RomHeader00FFB0)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var info mapping.RomInfo
+	info, gerr = mapping.ParseRomInfo(outHeaderBytes)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RomInfoResponse{
+		Uri:                     request.GetUri(),
+		MemoryMapping:           memoryMapping,
+		Confidence:              confidence,
+		Title:                   info.Title,
+		MapMode:                 uint32(info.MapMode),
+		FastRom:                 info.FastROM,
+		Chipset:                 uint32(info.Chipset),
+		ChipsetName:             info.ChipsetName,
+		RomSize:                 info.ROMSize,
+		SramSize:                info.SRAMSize,
+		ExpansionRamSize:        info.ExpansionRAMSize,
+		Region:                  uint32(info.Region),
+		RegionName:              info.RegionName,
+		DeveloperId:             uint32(info.DeveloperID),
+		MakerCode:               info.MakerCode,
+		Version:                 uint32(info.Version),
+		Checksum:                uint32(info.Checksum),
+		ChecksumComplement:      uint32(info.ChecksumComplement),
+		ChecksumComplementValid: info.ChecksumComplementValid,
+		RomHeader00FFB0:         outHeaderBytes,
+	}
+
+	// the ROM is summed while hashing it so the sum is cached along with the hashes:
+	if request.SumRom {
+		var h romhash.Hashes
+		if h, _, gerr = romhash.ForDevice(gctx, device, request.GetUri()); gerr != nil {
+			return nil, grpcError(gerr)
+		}
+		grsp.ChecksumValid = &h.ChecksumValid
+	}
+
+	return
+}
+
 func (s *DeviceMemoryService) SingleRead(
 	gctx context.Context,
 	request *sni.SingleReadMemoryRequest,
@@ -85,14 +164,16 @@ func (s *DeviceMemoryService) SingleRead(
 		return nil, status.Error(codes.Unimplemented, err.Error())
 	}
 
+	var address devices.AddressTuple
+	address, gerr = requestAddressTuple(symbols.NewResolver(gctx, device, request.GetUri()), request.Request)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
 	var mrsp []devices.MemoryReadResponse
 	mrsp, gerr = device.MultiReadMemory(gctx, devices.MemoryReadRequest{
-		RequestAddress: devices.AddressTuple{
-			Address:       request.Request.GetRequestAddress(),
-			AddressSpace:  request.Request.GetRequestAddressSpace(),
-			MemoryMapping: request.Request.GetRequestMemoryMapping(),
-		},
-		Size: int(request.Request.GetSize()),
+		RequestAddress: address,
+		Size:           int(request.Request.GetSize()),
 	})
 	if gerr != nil {
 		return nil, grpcError(gerr)
@@ -146,14 +227,17 @@ func (s *DeviceMemoryService) SingleWrite(
 		return nil, status.Error(codes.Unimplemented, err.Error())
 	}
 
+	var address devices.AddressTuple
+	address, gerr = requestAddressTuple(symbols.NewResolver(gctx, device, request.GetUri()), request.Request)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
 	var mrsp []devices.MemoryWriteResponse
 	mrsp, gerr = device.MultiWriteMemory(gctx, devices.MemoryWriteRequest{
-		RequestAddress: devices.AddressTuple{
-			Address:       request.Request.GetRequestAddress(),
-			AddressSpace:  request.Request.GetRequestAddressSpace(),
-			MemoryMapping: request.Request.GetRequestMemoryMapping(),
-		},
-		Data: request.Request.GetData(),
+		RequestAddress: address,
+		Data:           request.Request.GetData(),
+		Expected:       request.Request.GetExpected(),
 	})
 	if gerr != nil {
 		return nil, grpcError(gerr)
@@ -161,7 +245,7 @@ func (s *DeviceMemoryService) SingleWrite(
 	if len(mrsp) != 1 {
 		return nil, status.Error(codes.Internal, "single write must have a single response")
 	}
-	if actual, expected := mrsp[0].Size, len(request.Request.GetData()); actual != expected {
+	if actual, expected := mrsp[0].Size, expectedWriteSize(&mrsp[0], request.Request.GetData()); actual != expected {
 		gerr = status.Errorf(
 			codes.Internal,
 			"single write must return size of the written data; actual $%x expected $%x",
@@ -180,6 +264,7 @@ func (s *DeviceMemoryService) SingleWrite(
 			DeviceAddress:        mrsp[0].DeviceAddress.Address,
 			DeviceAddressSpace:   mrsp[0].DeviceAddress.AddressSpace,
 			Size:                 uint32(mrsp[0].Size),
+			CompareFailed:        mrsp[0].CompareFailed,
 		},
 	}
 
@@ -207,15 +292,18 @@ func (s *DeviceMemoryService) MultiRead(
 	}
 
 	var grsps []*sni.ReadMemoryResponse
+	resolver := symbols.NewResolver(gctx, device, request.GetUri())
 	reads := make([]devices.MemoryReadRequest, 0, len(request.Requests))
 	for _, req := range request.Requests {
+		var address devices.AddressTuple
+		address, gerr = requestAddressTuple(resolver, req)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+
 		reads = append(reads, devices.MemoryReadRequest{
-			RequestAddress: devices.AddressTuple{
-				Address:       req.GetRequestAddress(),
-				AddressSpace:  req.GetRequestAddressSpace(),
-				MemoryMapping: req.GetRequestMemoryMapping(),
-			},
-			Size: int(req.GetSize()),
+			RequestAddress: address,
+			Size:           int(req.GetSize()),
 		})
 	}
 
@@ -285,15 +373,19 @@ func (s *DeviceMemoryService) MultiWrite(
 		return nil, status.Error(codes.Unimplemented, err.Error())
 	}
 
+	resolver := symbols.NewResolver(gctx, device, request.GetUri())
 	writes := make([]devices.MemoryWriteRequest, 0, len(request.Requests))
 	for _, req := range request.Requests {
+		var address devices.AddressTuple
+		address, gerr = requestAddressTuple(resolver, req)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+
 		writes = append(writes, devices.MemoryWriteRequest{
-			RequestAddress: devices.AddressTuple{
-				Address:       req.GetRequestAddress(),
-				AddressSpace:  req.GetRequestAddressSpace(),
-				MemoryMapping: req.GetRequestMemoryMapping(),
-			},
-			Data: req.Data,
+			RequestAddress: address,
+			Data:           req.Data,
+			Expected:       req.Expected,
 		})
 	}
 
@@ -314,7 +406,7 @@ func (s *DeviceMemoryService) MultiWrite(
 
 	grsps := make([]*sni.WriteMemoryResponse, 0, len(mrsps))
 	for j, mrsp := range mrsps {
-		if actual, expected := mrsp.Size, len(writes[j].Data); actual != expected {
+		if actual, expected := mrsp.Size, expectedWriteSize(&mrsp, writes[j].Data); actual != expected {
 			gerr = status.Errorf(
 				codes.Internal,
 				"write[%d] must return size of the written data; actual $%x expected $%x",
@@ -332,9 +424,21 @@ func (s *DeviceMemoryService) MultiWrite(
 			DeviceAddress:        mrsp.DeviceAddress.Address,
 			DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
 			Size:                 uint32(mrsp.Size),
+			CompareFailed:        mrsp.CompareFailed,
 		})
 	}
 
+	if request.GetVerify() {
+		var mismatches []devices.WriteMismatch
+		mismatches, gerr = devices.VerifyWrites(gctx, device, writes, mrsps)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+		if len(mismatches) > 0 {
+			return nil, writeVerificationError(request.Uri, grsps, mismatches)
+		}
+	}
+
 	grsp = &sni.MultiWriteMemoryResponse{
 		Uri:       request.Uri,
 		Responses: grsps,
@@ -342,6 +446,40 @@ func (s *DeviceMemoryService) MultiWrite(
 	return
 }
 
+// writeVerificationError builds a DATA_LOSS status error with a WriteVerificationFailure detail listing all mismatches.
+func writeVerificationError(uri string, grsps []*sni.WriteMemoryResponse, mismatches []devices.WriteMismatch) error {
+	detail := &sni.WriteVerificationFailure{
+		Uri:        uri,
+		Responses:  grsps,
+		Mismatches: make([]*sni.WriteVerificationFailure_Mismatch, 0, len(mismatches)),
+	}
+	indexes := make([]string, 0, len(mismatches))
+	for _, m := range mismatches {
+		detail.Mismatches = append(detail.Mismatches, &sni.WriteVerificationFailure_Mismatch{
+			Index:  uint32(m.Index),
+			Offset: uint32(m.Offset),
+			Count:  uint32(m.Count),
+			Actual: m.Actual,
+		})
+		indexes = append(indexes, strconv.Itoa(m.Index))
+	}
+
+	st := status.Newf(codes.DataLoss, "written memory did not verify for write[%s]", strings.Join(indexes, ","))
+	if stWithDetails, err := st.WithDetails(detail); err == nil {
+		st = stWithDetails
+	}
+	return st.Err()
+}
+
+// expectedWriteSize returns the size a write response must report; conditional writes that failed to compare write
+// nothing.
+func expectedWriteSize(mrsp *devices.MemoryWriteResponse, data []byte) int {
+	if mrsp.CompareFailed {
+		return 0
+	}
+	return len(data)
+}
+
 func (s *DeviceMemoryService) StreamRead(stream sni.DeviceMemory_StreamReadServer) error {
 	for {
 		in, err := stream.Recv()
@@ -384,7 +522,166 @@ func (s *DeviceMemoryService) StreamWrite(stream sni.DeviceMemory_StreamWriteSer
 	}
 }
 
+func (s *DeviceMemoryService) WatchMemory(request *sni.WatchMemoryRequest, stream sni.DeviceMemory_WatchMemoryServer) error {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	driver, device, gerr := devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	resolver := symbols.NewResolver(stream.Context(), device, request.GetUri())
+	reads := make([]devices.MemoryReadRequest, 0, len(request.Requests))
+	for _, req := range request.Requests {
+		address, gerr := requestAddressTuple(resolver, req)
+		if gerr != nil {
+			return grpcError(gerr)
+		}
+
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: address,
+			Size:           int(req.GetSize()),
+		})
+	}
+
+	interval := time.Duration(request.GetPollIntervalMilliseconds()) * time.Millisecond
+	events, gerr := device.WatchMemory(stream.Context(), interval, reads...)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	for ev := range events {
+		if ev.Err != nil {
+			return grpcError(ev.Err)
+		}
+
+		grsps := make([]*sni.ReadMemoryResponse, 0, len(ev.Responses))
+		for _, mrsp := range ev.Responses {
+			grsps = append(grsps, &sni.ReadMemoryResponse{
+				RequestAddress:       mrsp.RequestAddress.Address,
+				RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
+				RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
+				DeviceAddress:        mrsp.DeviceAddress.Address,
+				DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
+				Data:                 mrsp.Data,
+			})
+		}
+		changed := make([]uint32, 0, len(ev.Changed))
+		for _, j := range ev.Changed {
+			changed = append(changed, uint32(j))
+		}
+
+		err = stream.Send(&sni.WatchMemoryResponse{
+			Uri:       request.Uri,
+			Responses: grsps,
+			Changed:   changed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *DeviceMemoryService) ReadTyped(gctx context.Context, request *sni.ReadTypedRequest) (grsp *sni.ReadTypedResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var sch *schema.Schema
+	if len(request.GetSchemaYaml()) > 0 {
+		sch, gerr = schema.Parse(request.GetSchemaYaml())
+	} else {
+		sch, gerr = schema.Load(request.GetSchema())
+	}
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var values map[string]schema.Value
+	values, gerr = sch.Read(gctx, device, request.GetFields())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ReadTypedResponse{
+		Uri:    request.GetUri(),
+		Schema: sch.Name,
+		Values: make(map[string]*sni.TypedValue, len(values)),
+	}
+	for name, value := range values {
+		grsp.Values[name] = typedValueToProto(value)
+	}
+	return
+}
+
+func typedValueToProto(value schema.Value) *sni.TypedValue {
+	switch v := value.(type) {
+	case uint64:
+		return &sni.TypedValue{Value: &sni.TypedValue_Uint{Uint: v}}
+	case int64:
+		return &sni.TypedValue{Value: &sni.TypedValue_Int{Int: v}}
+	case map[string]bool:
+		return &sni.TypedValue{Value: &sni.TypedValue_Bits_{Bits: &sni.TypedValue_Bits{Bits: v}}}
+	case []byte:
+		return &sni.TypedValue{Value: &sni.TypedValue_Bytes{Bytes: v}}
+	case []schema.Value:
+		array := &sni.TypedValue_Array{Values: make([]*sni.TypedValue, 0, len(v))}
+		for _, element := range v {
+			array.Values = append(array.Values, typedValueToProto(element))
+		}
+		return &sni.TypedValue{Value: &sni.TypedValue_Array_{Array: array}}
+	default:
+		return &sni.TypedValue{}
+	}
+}
+
+// labeledRequest is a ReadMemoryRequest or WriteMemoryRequest:
+type labeledRequest interface {
+	GetRequestAddress() uint32
+	GetRequestAddressSpace() sni.AddressSpace
+	GetRequestMemoryMapping() sni.MemoryMapping
+	GetRequestLabel() string
+}
+
+// requestAddressTuple returns the address a memory request refers to, resolving its label if it has one:
+func requestAddressTuple(resolver *symbols.Resolver, req labeledRequest) (devices.AddressTuple, error) {
+	if label := req.GetRequestLabel(); label != "" {
+		return resolver.Resolve(label, req.GetRequestMemoryMapping())
+	}
+
+	return devices.AddressTuple{
+		Address:       req.GetRequestAddress(),
+		AddressSpace:  req.GetRequestAddressSpace(),
+		MemoryMapping: req.GetRequestMemoryMapping(),
+	}, nil
+}
+
 func ReadMemoryRequestString(m *sni.ReadMemoryRequest) string {
+	if label := m.GetRequestLabel(); label != "" {
+		return fmt.Sprintf("{label:%q,size:%#x}", label, m.GetSize())
+	}
+
 	return fmt.Sprintf(
 		"{address:%s,size:%#x}",
 		&devices.AddressTuple{
@@ -397,6 +694,10 @@ func ReadMemoryRequestString(m *sni.ReadMemoryRequest) string {
 }
 
 func WriteMemoryRequestString(m *sni.WriteMemoryRequest) string {
+	if label := m.GetRequestLabel(); label != "" {
+		return fmt.Sprintf("{label:%q,size:%#x}", label, len(m.GetData()))
+	}
+
 	return fmt.Sprintf(
 		"{address:%s,size:%#x}",
 		&devices.AddressTuple{
@@ -421,6 +722,16 @@ func ReadMemoryResponseString(m *sni.ReadMemoryResponse) string {
 }
 
 func WriteMemoryResponseString(m *sni.WriteMemoryResponse) string {
+	if m.GetCompareFailed() {
+		return fmt.Sprintf(
+			"{address:%s,compareFailed:true}",
+			&devices.AddressTuple{
+				Address:       m.GetDeviceAddress(),
+				AddressSpace:  m.GetDeviceAddressSpace(),
+				MemoryMapping: m.GetRequestMemoryMapping(),
+			},
+		)
+	}
 	return fmt.Sprintf(
 		"{address:%s,size:%#x}",
 		&devices.AddressTuple{
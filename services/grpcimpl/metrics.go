@@ -0,0 +1,54 @@
+package grpcimpl
+
+import (
+	"errors"
+	"net/http"
+	"sni/services/auth"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sni_grpc_requests_total",
+		Help: "gRPC calls and streams completed, by status code.",
+	}, []string{"method", "code"})
+	grpcRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "sni_grpc_request_duration_seconds",
+		Help:    "Time taken to handle unary gRPC calls.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+	grpcActiveStreams = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sni_grpc_active_streams",
+		Help: "gRPC streams currently open.",
+	}, []string{"method"})
+)
+
+// metricsHandler serves all metrics of the default registry in the Prometheus exposition format:
+var metricsHandler = promhttp.Handler()
+
+func observeRequest(fullMethod string, duration time.Duration, err error) {
+	grpcRequests.WithLabelValues(fullMethod, status.Code(err).String()).Inc()
+	grpcRequestSeconds.WithLabelValues(fullMethod).Observe(duration.Seconds())
+}
+
+// serveMetrics serves all metrics in the Prometheus exposition format. When authentication is enabled, the scraper
+// must present a token with read permission as a bearer token.
+func serveMetrics(rw http.ResponseWriter, req *http.Request) {
+	token := strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	if err := auth.Check(token, auth.PermissionRead); err != nil {
+		if errors.Is(err, auth.ErrUnauthenticated) {
+			rw.WriteHeader(http.StatusUnauthorized)
+		} else {
+			rw.WriteHeader(http.StatusForbidden)
+		}
+		return
+	}
+
+	metricsHandler.ServeHTTP(rw, req)
+}
@@ -0,0 +1,41 @@
+package grpcimpl
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sni/cmd/sni/config"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServeMetrics(t *testing.T) {
+	config.Config.Set("auth_tokens", "reader=read")
+	t.Cleanup(func() { config.Config.Set("auth_tokens", "") })
+
+	observeRequest("/Test/Metrics", time.Millisecond, errors.New("failed"))
+
+	scrape := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		serveMetrics(rec, req)
+		return rec
+	}
+
+	if rec := scrape(""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("scrape without token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	rec := scrape("reader")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("scrape: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	want := `sni_grpc_requests_total{code="Unknown",method="/Test/Metrics"} 1`
+	if body := rec.Body.String(); !strings.Contains(body, want) {
+		t.Fatalf("scrape: expected %q in:\n%s", want, body)
+	}
+}
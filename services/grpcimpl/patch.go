@@ -0,0 +1,81 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/patch"
+	"sni/devices/snes/romhash"
+	"sni/protos/sni"
+	"sni/services/auth"
+)
+
+type DevicePatchService struct {
+	sni.UnimplementedDevicePatchServer
+}
+
+func (s *DevicePatchService) ApplyPatch(gctx context.Context, request *sni.ApplyPatchRequest) (grsp *sni.ApplyPatchResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if request.GetTarget() == sni.PatchTarget_PatchFile {
+		// patching a file also requires the permissions of GetFile and PutFile:
+		if gerr = authorizePermission(gctx, auth.PermissionFilesystem); gerr != nil {
+			return
+		}
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var result patch.Result
+	switch request.GetTarget() {
+	case sni.PatchTarget_PatchRomMemory:
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+
+		result, gerr = patch.ToMemory(gctx, device, request.GetPatch(), patch.Format(request.GetFormat()), request.GetMemoryMapping())
+		// the ROM may have been partially written even on failure:
+		romhash.Forget(request.GetUri())
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	case sni.PatchTarget_PatchFile:
+		if request.GetPath() == "" {
+			return nil, status.Error(codes.InvalidArgument, "path is required to patch a file")
+		}
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_GetFile, sni.DeviceCapability_PutFile); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+
+		result, gerr = patch.ToFile(gctx, device, request.GetPatch(), patch.Format(request.GetFormat()), request.GetPath(), request.GetOutputPath())
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown patch target")
+	}
+
+	grsp = &sni.ApplyPatchResponse{
+		Uri:    request.GetUri(),
+		Format: sni.PatchFormat(result.Format),
+		Writes: uint32(result.Writes),
+		Size:   uint32(result.Size),
+	}
+	if request.GetTarget() == sni.PatchTarget_PatchFile {
+		grsp.Path = request.GetOutputPath()
+		if grsp.Path == "" {
+			grsp.Path = request.GetPath()
+		}
+	}
+	return
+}
@@ -0,0 +1,160 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/search"
+	"sni/devices/snes/snapshot"
+	"sni/protos/sni"
+)
+
+// defaultSearchLimit is the number of candidates returned when a request does not set a limit:
+const defaultSearchLimit = 100
+
+type MemorySearchService struct {
+	sni.UnimplementedMemorySearchServer
+}
+
+// searchDevice finds the device to read memory from for a search:
+func searchDevice(uriString string) (device devices.AutoCloseableDevice, gerr error) {
+	uri, err := url.Parse(uriString)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	return
+}
+
+func searchResponse(session *search.Session, start uint32, limit uint32) *sni.SearchResponse {
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	total, candidates := session.Candidates(int(start), int(limit))
+	grsp := &sni.SearchResponse{
+		SearchId:             session.ID,
+		Uri:                  session.Uri,
+		RequestAddressSpace:  session.Region.AddressSpace,
+		RequestMemoryMapping: session.Region.MemoryMapping,
+		Width:                uint32(session.Width),
+		CandidateCount:       uint32(total),
+		Candidates:           make([]*sni.SearchResponse_Candidate, 0, len(candidates)),
+	}
+	for _, c := range candidates {
+		grsp.Candidates = append(grsp.Candidates, &sni.SearchResponse_Candidate{
+			Address:       c.Address,
+			Value:         c.Value,
+			PreviousValue: c.PreviousValue,
+		})
+	}
+	return grsp
+}
+
+func (s *MemorySearchService) StartSearch(gctx context.Context, request *sni.StartSearchRequest) (grsp *sni.SearchResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = searchDevice(request.GetUri())
+	if gerr != nil {
+		return
+	}
+
+	region := devices.AddressTuple{
+		Address:       request.GetRequestAddress(),
+		AddressSpace:  request.GetRequestAddressSpace(),
+		MemoryMapping: request.GetRequestMemoryMapping(),
+	}
+	size := request.GetSize()
+	if name := request.GetRegion(); name != "" {
+		named, ok := snapshot.RegionByName(name)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown region '%s'", name)
+		}
+
+		region = devices.AddressTuple{
+			Address:       named.Address,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: sni.MemoryMapping_Unknown,
+		}
+		if named.Size == 0 {
+			// SRAM is sized by the ROM header:
+			mapping, header, err := snapshot.DetectRom(gctx, device)
+			if err != nil {
+				return nil, grpcError(err)
+			}
+			region.MemoryMapping = mapping
+			named.Size = snapshot.SRAMSize(header)
+			if named.Size == 0 {
+				return nil, status.Error(codes.FailedPrecondition, "the loaded ROM has no SRAM")
+			}
+		}
+		if size == 0 || size > named.Size {
+			size = named.Size
+		}
+	}
+
+	width := request.GetWidth()
+	if width == 0 {
+		width = 1
+	}
+
+	var session *search.Session
+	session, gerr = search.Start(gctx, device, request.GetUri(), region, int(size), int(width))
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+	search.Add(session)
+
+	grsp = searchResponse(session, 0, request.GetLimit())
+	return
+}
+
+func (s *MemorySearchService) RefineSearch(gctx context.Context, request *sni.RefineSearchRequest) (grsp *sni.SearchResponse, gerr error) {
+	var session *search.Session
+	session, gerr = search.Get(request.GetSearchId())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var device devices.AutoCloseableDevice
+	device, gerr = searchDevice(session.Uri)
+	if gerr != nil {
+		return
+	}
+
+	gerr = session.Refine(gctx, device, search.Predicate(request.GetPredicate()), request.GetValue())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = searchResponse(session, 0, request.GetLimit())
+	return
+}
+
+func (s *MemorySearchService) GetSearchResults(_ context.Context, request *sni.GetSearchResultsRequest) (grsp *sni.SearchResponse, gerr error) {
+	var session *search.Session
+	session, gerr = search.Get(request.GetSearchId())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = searchResponse(session, request.GetStart(), request.GetLimit())
+	return
+}
+
+func (s *MemorySearchService) EndSearch(_ context.Context, request *sni.EndSearchRequest) (grsp *sni.EndSearchResponse, gerr error) {
+	search.End(request.GetSearchId())
+	grsp = &sni.EndSearchResponse{}
+	return
+}
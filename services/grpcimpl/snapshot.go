@@ -0,0 +1,205 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/snapshot"
+	"sni/protos/sni"
+	"time"
+)
+
+type DeviceSnapshotService struct {
+	sni.UnimplementedDeviceSnapshotServer
+}
+
+func snapshotRegionToProto(region snapshot.Region) *sni.SnapshotRegion {
+	return &sni.SnapshotRegion{
+		Name:    region.Name,
+		Address: region.Address,
+		Size:    region.Size,
+	}
+}
+
+func snapshotInfoToProto(s *snapshot.Snapshot) *sni.SnapshotInfo {
+	info := &sni.SnapshotInfo{
+		Name:                      s.Name,
+		Uri:                       s.Uri,
+		CreatedAtUnixMilliseconds: s.Created.UnixMilli(),
+		MemoryMapping:             s.Mapping,
+		RomTitle:                  s.RomTitle,
+		RomChecksum:               uint32(s.RomChecksum),
+		Regions:                   make([]*sni.SnapshotRegion, 0, len(s.Regions)),
+	}
+	for _, region := range s.Regions {
+		info.Regions = append(info.Regions, snapshotRegionToProto(region.Region))
+	}
+	return info
+}
+
+// snapshotDevice finds the device to take or restore a snapshot with and checks it has the given capabilities:
+func snapshotDevice(uriString string, capabilities ...sni.DeviceCapability) (device devices.AutoCloseableDevice, gerr error) {
+	uri, err := url.Parse(uriString)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(capabilities...); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	return
+}
+
+func (s *DeviceSnapshotService) TakeSnapshot(gctx context.Context, request *sni.TakeSnapshotRequest) (grsp *sni.TakeSnapshotResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = snapshotDevice(request.GetUri(), sni.DeviceCapability_ReadMemory)
+	if gerr != nil {
+		return
+	}
+
+	name := request.GetName()
+	if name == "" {
+		name = snapshot.NewName(time.Now())
+	}
+
+	var snap *snapshot.Snapshot
+	snap, gerr = snapshot.Take(gctx, device, request.GetUri(), name, request.GetRegions())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if gerr = snapshot.Save(snap); gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.TakeSnapshotResponse{
+		Uri:      request.GetUri(),
+		Snapshot: snapshotInfoToProto(snap),
+	}
+
+	return
+}
+
+func (s *DeviceSnapshotService) RestoreSnapshot(gctx context.Context, request *sni.RestoreSnapshotRequest) (grsp *sni.RestoreSnapshotResponse, gerr error) {
+	var device devices.AutoCloseableDevice
+	device, gerr = snapshotDevice(request.GetUri(), sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory)
+	if gerr != nil {
+		return
+	}
+
+	var snap *snapshot.Snapshot
+	snap, gerr = snapshot.Load(request.GetName())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var restored, skipped []snapshot.Region
+	restored, skipped, gerr = snap.Restore(gctx, device, request.GetRegions(), request.GetForce())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RestoreSnapshotResponse{
+		Uri:      request.GetUri(),
+		Snapshot: snapshotInfoToProto(snap),
+		Restored: make([]*sni.SnapshotRegion, 0, len(restored)),
+		Skipped:  make([]*sni.SnapshotRegion, 0, len(skipped)),
+	}
+	for _, region := range restored {
+		grsp.Restored = append(grsp.Restored, snapshotRegionToProto(region))
+	}
+	for _, region := range skipped {
+		grsp.Skipped = append(grsp.Skipped, snapshotRegionToProto(region))
+	}
+
+	return
+}
+
+func (s *DeviceSnapshotService) ListSnapshots(gctx context.Context, request *sni.ListSnapshotsRequest) (grsp *sni.ListSnapshotsResponse, gerr error) {
+	var snaps []*snapshot.Snapshot
+	snaps, gerr = snapshot.List()
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ListSnapshotsResponse{
+		Snapshots: make([]*sni.SnapshotInfo, 0, len(snaps)),
+	}
+	for _, snap := range snaps {
+		grsp.Snapshots = append(grsp.Snapshots, snapshotInfoToProto(snap))
+	}
+
+	return
+}
+
+func (s *DeviceSnapshotService) DiffSnapshots(gctx context.Context, request *sni.DiffSnapshotsRequest) (grsp *sni.DiffSnapshotsResponse, gerr error) {
+	var before, after *snapshot.Snapshot
+	before, gerr = snapshot.Load(request.GetName())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if request.GetOtherName() != "" {
+		after, gerr = snapshot.Load(request.GetOtherName())
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	} else {
+		if request.GetUri() == "" {
+			return nil, status.Error(codes.InvalidArgument, "either otherName or uri must be set")
+		}
+
+		var device devices.AutoCloseableDevice
+		device, gerr = snapshotDevice(request.GetUri(), sni.DeviceCapability_ReadMemory)
+		if gerr != nil {
+			return
+		}
+
+		// capture the same regions as the snapshot being compared from:
+		regionNames := make([]string, 0, len(before.Regions))
+		for _, region := range before.Regions {
+			regionNames = append(regionNames, region.Name)
+		}
+		after, gerr = snapshot.Take(gctx, device, request.GetUri(), "", regionNames)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	}
+
+	mergeGap := int(request.GetMergeGap())
+	if mergeGap < 1 {
+		mergeGap = 1
+	}
+
+	diffs := snapshot.Diff(before, after, request.GetRegions(), mergeGap)
+
+	grsp = &sni.DiffSnapshotsResponse{
+		Regions: make([]*sni.DiffSnapshotsResponse_RegionDiff, 0, len(diffs)),
+	}
+	for _, diff := range diffs {
+		rd := &sni.DiffSnapshotsResponse_RegionDiff{
+			Region:       snapshotRegionToProto(diff.Region),
+			Changes:      make([]*sni.DiffSnapshotsResponse_Change, 0, len(diff.Changes)),
+			ChangedBytes: diff.ChangedBytes,
+		}
+		for _, change := range diff.Changes {
+			rd.Changes = append(rd.Changes, &sni.DiffSnapshotsResponse_Change{
+				Offset: change.Offset,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+		grsp.Regions = append(grsp.Regions, rd)
+	}
+
+	return
+}
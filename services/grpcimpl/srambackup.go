@@ -0,0 +1,101 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+	"sni/services/srambackup"
+)
+
+type SramBackupsService struct {
+	sni.UnimplementedSramBackupsServer
+}
+
+func sramBackupToProto(b *srambackup.Backup) *sni.SramBackup {
+	return &sni.SramBackup{
+		Rom:                       b.RomKey,
+		RomTitle:                  b.Rom.Title,
+		RomChecksum:               uint32(b.Rom.Checksum),
+		Id:                        b.ID,
+		CreatedAtUnixMilliseconds: b.Created.UnixMilli(),
+		Size:                      b.Size,
+	}
+}
+
+func (s *SramBackupsService) ListSramBackups(gctx context.Context, request *sni.ListSramBackupsRequest) (grsp *sni.ListSramBackupsResponse, gerr error) {
+	romKey := request.GetRom()
+	if romKey == "" && request.GetUri() != "" {
+		uri, err := url.Parse(request.GetUri())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var driver devices.Driver
+		var device devices.AutoCloseableDevice
+		driver, device, gerr = devices.DeviceByUri(uri)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+
+		romKey, gerr = srambackup.CurrentRomKey(gctx, device)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	}
+
+	var backups []srambackup.Backup
+	backups, gerr = srambackup.List(romKey)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ListSramBackupsResponse{
+		Backups: make([]*sni.SramBackup, 0, len(backups)),
+	}
+	for i := range backups {
+		grsp.Backups = append(grsp.Backups, sramBackupToProto(&backups[i]))
+	}
+
+	return
+}
+
+func (s *SramBackupsService) RestoreSramBackup(gctx context.Context, request *sni.RestoreSramBackupRequest) (grsp *sni.RestoreSramBackupResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.GetRom() == "" {
+		return nil, status.Error(codes.InvalidArgument, "rom must be set")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var backup srambackup.Backup
+	backup, gerr = srambackup.Restore(gctx, device, request.GetUri(), request.GetRom(), request.GetId(), request.GetForce())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RestoreSramBackupResponse{
+		Uri:    request.GetUri(),
+		Backup: sramBackupToProto(&backup),
+	}
+
+	return
+}
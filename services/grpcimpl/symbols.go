@@ -0,0 +1,105 @@
+package grpcimpl
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/devices"
+	"sni/devices/snes/symbols"
+	"sni/protos/sni"
+)
+
+type SymbolsService struct {
+	sni.UnimplementedSymbolsServer
+}
+
+// checkRomSha1 requires a ROM SHA-1 when no uri is given:
+func checkRomSha1(uri string, romSha1 string) error {
+	if uri != "" {
+		return nil
+	}
+	if romSha1 == "" {
+		return status.Error(codes.InvalidArgument, "either uri or romSha1 must be given")
+	}
+	if b, err := hex.DecodeString(romSha1); err != nil || len(b) != sha1.Size {
+		return status.Error(codes.InvalidArgument, "romSha1 must be a SHA-1 in hex")
+	}
+	return nil
+}
+
+func (s *SymbolsService) LoadSymbols(_ context.Context, request *sni.LoadSymbolsRequest) (grsp *sni.LoadSymbolsResponse, gerr error) {
+	if gerr = checkRomSha1(request.GetUri(), request.GetRomSha1()); gerr != nil {
+		return
+	}
+
+	var table *symbols.Table
+	table, gerr = symbols.Parse(request.GetData(), symbols.Format(request.GetFormat()))
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if request.GetUri() != "" {
+		symbols.LoadForDevice(request.GetUri(), table)
+	} else {
+		symbols.LoadForRom(request.GetRomSha1(), table)
+	}
+
+	grsp = &sni.LoadSymbolsResponse{
+		LabelCount: uint32(len(table.Labels)),
+	}
+	return
+}
+
+func (s *SymbolsService) UnloadSymbols(_ context.Context, request *sni.UnloadSymbolsRequest) (grsp *sni.UnloadSymbolsResponse, gerr error) {
+	if gerr = checkRomSha1(request.GetUri(), request.GetRomSha1()); gerr != nil {
+		return
+	}
+
+	var unloaded bool
+	if request.GetUri() != "" {
+		unloaded = symbols.UnloadForDevice(request.GetUri())
+	} else {
+		unloaded = symbols.UnloadForRom(request.GetRomSha1())
+	}
+
+	grsp = &sni.UnloadSymbolsResponse{
+		Unloaded: unloaded,
+	}
+	return
+}
+
+func (s *SymbolsService) ResolveLabel(gctx context.Context, request *sni.ResolveLabelRequest) (grsp *sni.ResolveLabelResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var address devices.AddressTuple
+	address, gerr = symbols.NewResolver(gctx, device, request.GetUri()).Resolve(request.GetLabel(), request.GetMemoryMapping())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ResolveLabelResponse{
+		Uri:           request.GetUri(),
+		Label:         request.GetLabel(),
+		Address:       address.Address,
+		AddressSpace:  address.AddressSpace,
+		MemoryMapping: address.MemoryMapping,
+	}
+	return
+}
@@ -0,0 +1,36 @@
+package grpcimpl
+
+import (
+	"context"
+	"sni/protos/sni"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadSymbols_RequiresUriOrRomSha1(t *testing.T) {
+	s := &SymbolsService{}
+	data := []byte("[labels]\n00:8000 Reset\n")
+
+	for _, romSha1 := range []string{"", "beef", "zz6ff83afe7d9b1aa6d6f4ee7ce5fe9d0a8fcd30"} {
+		_, err := s.LoadSymbols(context.Background(), &sni.LoadSymbolsRequest{RomSha1: romSha1, Data: data})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("romSha1 %q: expected InvalidArgument but got %v", romSha1, err)
+		}
+	}
+
+	_, err := s.UnloadSymbols(context.Background(), &sni.UnloadSymbolsRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument but got %v", err)
+	}
+
+	romSha1 := "da957f0d63d14cb441d215462904c4fa8519c613"
+	rsp, err := s.LoadSymbols(context.Background(), &sni.LoadSymbolsRequest{RomSha1: romSha1, Data: data})
+	if err != nil || rsp.LabelCount != 1 {
+		t.Fatalf("expected 1 label loaded: %v", err)
+	}
+	if unloaded, err := s.UnloadSymbols(context.Background(), &sni.UnloadSymbolsRequest{RomSha1: romSha1}); err != nil || !unloaded.Unloaded {
+		t.Fatalf("expected the symbols to be unloaded: %v", err)
+	}
+}
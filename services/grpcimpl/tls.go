@@ -0,0 +1,267 @@
+package grpcimpl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"time"
+)
+
+const (
+	tlsCAFile      = "ca.pem"
+	tlsCAKeyFile   = "ca-key.pem"
+	tlsCertFile    = "server.pem"
+	tlsCertKeyFile = "server-key.pem"
+
+	tlsCAValidity   = time.Hour * 24 * 365 * 10
+	tlsCertValidity = time.Hour * 24 * 365
+	// tlsCertRenewBefore is how long before it expires a generated server certificate is reissued:
+	tlsCertRenewBefore = time.Hour * 24 * 30
+)
+
+// tlsCAPath is the path of the generated CA certificate, if any, served to clients so they can trust it:
+var tlsCAPath string
+
+// loadTLSConfig returns the TLS configuration for the gRPC and gRPC-web listeners, or nil if TLS is disabled. When no
+// certificate is configured, a self-signed CA and a server certificate issued by it are generated in config.Dir and
+// reused on subsequent runs.
+func loadTLSConfig() (cfg *tls.Config, err error) {
+	if !config.Config.GetBool("grpc_tls_enable") {
+		return nil, nil
+	}
+
+	certFile := config.Config.GetString("grpc_tls_cert_file")
+	keyFile := config.Config.GetString("grpc_tls_key_file")
+	if certFile == "" && keyFile == "" {
+		dir := filepath.Join(config.Dir, "tls")
+		if err = ensureGeneratedCert(dir, tlsHostnames(), tlsIPAddresses(), time.Now()); err != nil {
+			return
+		}
+		certFile = filepath.Join(dir, tlsCertFile)
+		keyFile = filepath.Join(dir, tlsCertKeyFile)
+		tlsCAPath = filepath.Join(dir, tlsCAFile)
+		log.Printf("grpc: tls: using generated certificate; import CA certificate '%s' into your browser or OS trust store\n", tlsCAPath)
+	} else if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("grpc: tls: both grpc_tls_cert_file and grpc_tls_key_file must be set")
+	}
+
+	var cert tls.Certificate
+	cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("grpc: tls: %w", err)
+	}
+
+	cfg = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return
+}
+
+// tlsHostnames returns the DNS names to include in a generated server certificate:
+func tlsHostnames() []string {
+	names := []string{"localhost"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" && hostname != "localhost" {
+		names = append(names, hostname)
+	}
+	return names
+}
+
+// tlsIPAddresses returns the IP addresses of all local interfaces to include in a generated server certificate:
+func tlsIPAddresses() (ips []net.IP) {
+	ips = []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return
+	}
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok || ipnet.IP.IsLoopback() || ipnet.IP.IsLinkLocalUnicast() {
+			continue
+		}
+		ips = append(ips, ipnet.IP)
+	}
+	return
+}
+
+// ensureGeneratedCert makes sure dir contains a CA and a server certificate issued by it that is valid at now for all
+// of the given names and IPs. The CA is only generated once so that clients only need to trust it once; the server
+// certificate is reissued when it is about to expire or the names or IPs of this machine changed.
+func ensureGeneratedCert(dir string, names []string, ips []net.IP, now time.Time) (err error) {
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+
+	var ca *x509.Certificate
+	var caKey *ecdsa.PrivateKey
+	ca, caKey, err = loadCertAndKey(filepath.Join(dir, tlsCAFile), filepath.Join(dir, tlsCAKeyFile))
+	if errors.Is(err, fs.ErrNotExist) || (err == nil && !now.Before(ca.NotAfter)) {
+		log.Printf("grpc: tls: generating CA certificate in '%s'\n", dir)
+		ca, caKey, err = generateCert(dir, tlsCAFile, tlsCAKeyFile, &x509.Certificate{
+			Subject:               pkix.Name{CommonName: "SNI Local CA"},
+			NotBefore:             now.Add(-time.Hour),
+			NotAfter:              now.Add(tlsCAValidity),
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+			MaxPathLenZero:        true,
+		}, nil, nil)
+		if err != nil {
+			return
+		}
+		// force the server certificate to be reissued by the new CA:
+		_ = os.Remove(filepath.Join(dir, tlsCertFile))
+	}
+	if err != nil {
+		return fmt.Errorf("grpc: tls: %w", err)
+	}
+
+	cert, _, err := loadCertAndKey(filepath.Join(dir, tlsCertFile), filepath.Join(dir, tlsCertKeyFile))
+	if err == nil && certCovers(cert, ca, names, ips, now.Add(tlsCertRenewBefore)) {
+		return nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("grpc: tls: reissuing server certificate: %v\n", err)
+	}
+
+	log.Printf("grpc: tls: generating server certificate in '%s'\n", dir)
+	_, _, err = generateCert(dir, tlsCertFile, tlsCertKeyFile, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: names[0]},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(tlsCertValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:    names,
+		IPAddresses: ips,
+	}, ca, caKey)
+	return
+}
+
+// certCovers returns true if cert was issued by ca and is valid at the given time for all the given names and IPs:
+func certCovers(cert *x509.Certificate, ca *x509.Certificate, names []string, ips []net.IP, at time.Time) bool {
+	if !at.Before(cert.NotAfter) || cert.CheckSignatureFrom(ca) != nil {
+		return false
+	}
+	for _, name := range names {
+		if cert.VerifyHostname(name) != nil {
+			return false
+		}
+	}
+	for _, ip := range ips {
+		found := false
+		for _, certIP := range cert.IPAddresses {
+			if certIP.Equal(ip) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// generateCert creates a new key and a certificate from template signed by parent, or self-signed if parent is nil,
+// and writes both to dir as PEM files.
+func generateCert(
+	dir, certFile, keyFile string,
+	template, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (cert *x509.Certificate, key *ecdsa.PrivateKey, err error) {
+	key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return
+	}
+
+	template.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	var der []byte
+	der, err = x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		return
+	}
+
+	var keyDer []byte
+	keyDer, err = x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return
+	}
+
+	// write the key first so a certificate is never left without its key:
+	err = os.WriteFile(filepath.Join(dir, keyFile), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(filepath.Join(dir, certFile), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+	return
+}
+
+func loadCertAndKey(certPath, keyPath string) (cert *x509.Certificate, key *ecdsa.PrivateKey, err error) {
+	var certPem, keyPem []byte
+	if certPem, err = os.ReadFile(certPath); err != nil {
+		return
+	}
+	if keyPem, err = os.ReadFile(keyPath); err != nil {
+		return
+	}
+
+	certBlock, _ := pem.Decode(certPem)
+	if certBlock == nil || certBlock.Type != "CERTIFICATE" {
+		return nil, nil, fmt.Errorf("%s: no certificate found", certPath)
+	}
+	if cert, err = x509.ParseCertificate(certBlock.Bytes); err != nil {
+		return
+	}
+
+	keyBlock, _ := pem.Decode(keyPem)
+	if keyBlock == nil || keyBlock.Type != "EC PRIVATE KEY" {
+		return nil, nil, fmt.Errorf("%s: no EC private key found", keyPath)
+	}
+	if key, err = x509.ParseECPrivateKey(keyBlock.Bytes); err != nil {
+		return
+	}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !pub.Equal(&key.PublicKey) {
+		return nil, nil, fmt.Errorf("%s: key does not match certificate %s", keyPath, certPath)
+	}
+	return
+}
+
+// readCACert returns the PEM encoded generated CA certificate or nil if no CA was generated:
+func readCACert() []byte {
+	if tlsCAPath == "" {
+		return nil
+	}
+	caPem, err := os.ReadFile(tlsCAPath)
+	if err != nil {
+		return nil
+	}
+	return caPem
+}
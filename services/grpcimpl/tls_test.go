@@ -0,0 +1,76 @@
+package grpcimpl
+
+import (
+	"crypto/x509"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func loadGeneratedCerts(t *testing.T, dir string) (ca, cert *x509.Certificate) {
+	t.Helper()
+	var err error
+	if ca, _, err = loadCertAndKey(filepath.Join(dir, tlsCAFile), filepath.Join(dir, tlsCAKeyFile)); err != nil {
+		t.Fatal(err)
+	}
+	if cert, _, err = loadCertAndKey(filepath.Join(dir, tlsCertFile), filepath.Join(dir, tlsCertKeyFile)); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func TestEnsureGeneratedCert(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{"localhost", "sni-host"}
+	ips := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv4(192, 168, 1, 20)}
+	now := time.Now()
+
+	if err := ensureGeneratedCert(dir, names, ips, now); err != nil {
+		t.Fatal(err)
+	}
+	ca, cert := loadGeneratedCerts(t, dir)
+
+	// the server certificate must verify against the CA for every name and IP:
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	for _, host := range []string{"localhost", "sni-host", "127.0.0.1", "192.168.1.20"} {
+		if _, err := cert.Verify(x509.VerifyOptions{DNSName: host, Roots: roots}); err != nil {
+			t.Errorf("verify %s: %v", host, err)
+		}
+	}
+
+	// certificates are reused when nothing changed:
+	if err := ensureGeneratedCert(dir, names, ips, now); err != nil {
+		t.Fatal(err)
+	}
+	ca2, cert2 := loadGeneratedCerts(t, dir)
+	if !ca2.Equal(ca) || !cert2.Equal(cert) {
+		t.Fatal("expected generated certificates to be reused")
+	}
+
+	// a new IP reissues the server certificate from the same CA:
+	ips = append(ips, net.IPv4(10, 0, 0, 5))
+	if err := ensureGeneratedCert(dir, names, ips, now); err != nil {
+		t.Fatal(err)
+	}
+	ca3, cert3 := loadGeneratedCerts(t, dir)
+	if !ca3.Equal(ca) {
+		t.Fatal("expected CA to be kept")
+	}
+	if cert3.Equal(cert) {
+		t.Fatal("expected server certificate to be reissued")
+	}
+	if _, err := cert3.Verify(x509.VerifyOptions{DNSName: "10.0.0.5", Roots: roots}); err != nil {
+		t.Errorf("verify reissued: %v", err)
+	}
+
+	// a server certificate about to expire is reissued:
+	if err := ensureGeneratedCert(dir, names, ips, now.Add(tlsCertValidity-tlsCertRenewBefore/2)); err != nil {
+		t.Fatal(err)
+	}
+	_, cert4 := loadGeneratedCerts(t, dir)
+	if cert4.Equal(cert3) {
+		t.Fatal("expected expiring server certificate to be reissued")
+	}
+}
@@ -0,0 +1,191 @@
+// Package srambackup periodically backs up the SRAM of the games running on connected devices so that saves lost to
+// a glitching cartridge can be restored.
+package srambackup
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"log"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/snapshot"
+	"sni/protos/sni"
+	"sni/util"
+	"strings"
+	"time"
+)
+
+// backupTimeout bounds how long a single backup waits for a device, e.g. while another client holds a lease:
+const backupTimeout = time.Second * 10
+
+// Start begins backing up SRAM in the background if enabled by configuration.
+func Start() {
+	if !config.Config.GetBool("sram_backup_enable") {
+		return
+	}
+
+	interval := time.Duration(config.Config.GetInt("sram_backup_interval_seconds")) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	log.Printf("srambackup: backing up SRAM every %s to '%s'\n", interval, Dir())
+	go run(context.Background(), interval)
+}
+
+// configuredKinds returns the device kinds to back up, e.g. "fxpakpro":
+func configuredKinds() (kinds []string) {
+	for _, kind := range strings.Split(config.Config.GetString("sram_backup_kinds"), ",") {
+		kind = strings.TrimSpace(kind)
+		if kind != "" {
+			kinds = append(kinds, kind)
+		}
+	}
+	return
+}
+
+func shouldBackup(descriptor *devices.DeviceDescriptor, kinds []string) bool {
+	kindOk := false
+	for _, kind := range kinds {
+		if kind == "*" || strings.EqualFold(kind, descriptor.Kind) {
+			kindOk = true
+			break
+		}
+	}
+	if !kindOk {
+		return false
+	}
+
+	ok, _ := devices.CheckCapabilities([]sni.DeviceCapability{sni.DeviceCapability_ReadMemory}, descriptor.Capabilities)
+	return ok
+}
+
+func run(ctx context.Context, interval time.Duration) {
+	defer util.Recover()
+
+	kinds := configuredKinds()
+	keep := config.Config.GetInt("sram_backup_keep")
+
+	var current []devices.DeviceDescriptor
+	watch := devices.WatchDevices(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case ev, ok := <-watch:
+			if !ok {
+				return
+			}
+			current = ev.Devices
+		case <-ticker.C:
+			for i := range current {
+				if !shouldBackup(&current[i], kinds) {
+					continue
+				}
+
+				uri := current[i].Uri
+				b, saved, err := BackupDevice(ctx, &uri, keep)
+				if err != nil {
+					log.Printf("srambackup: %s: %v\n", &uri, err)
+					continue
+				}
+				if saved {
+					log.Printf("srambackup: %s: saved backup '%s' of '%s'\n", &uri, b.ID, b.RomKey)
+				}
+			}
+		}
+	}
+}
+
+// readSram reads the SRAM of the game currently running on the device. ok is false if the game has no SRAM.
+func readSram(ctx context.Context, device devices.DeviceMemory, uri string) (rom Rom, sram *snapshot.RegionData, memoryMapping sni.MemoryMapping, ok bool, err error) {
+	var snap *snapshot.Snapshot
+	snap, err = snapshot.Take(ctx, device, uri, "", []string{"SRAM"})
+	if err != nil {
+		return
+	}
+
+	rom = Rom{Title: snap.RomTitle, Checksum: snap.RomChecksum}
+	memoryMapping = snap.Mapping
+	sram, ok = snap.Region("SRAM")
+	return
+}
+
+// BackupDevice reads the SRAM of the game currently running on the device at uri and stores it unless it is identical
+// to a kept backup. saved is false if nothing new was stored, including when the game has no SRAM.
+func BackupDevice(ctx context.Context, uri *url.URL, keep int) (b Backup, saved bool, err error) {
+	var device devices.AutoCloseableDevice
+	if _, device, err = devices.DeviceByUri(uri); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backupTimeout)
+	defer cancel()
+
+	rom, sram, _, ok, err := readSram(ctx, device, uri.String())
+	if err != nil || !ok {
+		return
+	}
+
+	return Save(rom, sram.Data, time.Now(), keep)
+}
+
+// CurrentRomKey returns the key of the game currently running on the device.
+func CurrentRomKey(ctx context.Context, device devices.DeviceMemory) (romKey string, err error) {
+	_, header, err := snapshot.DetectRom(ctx, device)
+	if err != nil {
+		return
+	}
+	return Rom{Title: snapshot.RomTitle(header), Checksum: header.CheckSum}.Key(), nil
+}
+
+// Restore writes a backup, or the most recent backup if id is empty, to the SRAM of the device. The game currently
+// running must be the one the backup was taken from unless force is true. The current SRAM contents are backed up
+// first so that the restore can be undone.
+func Restore(ctx context.Context, device devices.DeviceMemory, uri string, romKey string, id string, force bool) (b Backup, err error) {
+	var data []byte
+	if b, data, err = Load(romKey, id); err != nil {
+		return
+	}
+
+	var rom Rom
+	var current *snapshot.RegionData
+	var memoryMapping sni.MemoryMapping
+	var ok bool
+	if rom, current, memoryMapping, ok, err = readSram(ctx, device, uri); err != nil {
+		return
+	}
+	if !ok {
+		return b, devices.WithCode(codes.FailedPrecondition, fmt.Errorf("srambackup: game '%s' has no SRAM", rom.Title))
+	}
+	if !force && rom.Key() != romKey {
+		return b, devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("srambackup: backup is of rom '%s' but '%s' is running", romKey, rom.Key()),
+		)
+	}
+	if len(data) > len(current.Data) {
+		return b, devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("srambackup: backup is $%x bytes but SRAM is only $%x bytes", len(data), len(current.Data)),
+		)
+	}
+
+	if _, _, err = Save(rom, current.Data, time.Now(), config.Config.GetInt("sram_backup_keep")); err != nil {
+		return
+	}
+
+	_, err = device.MultiWriteMemory(ctx, devices.MemoryWriteRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       current.Address,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: memoryMapping,
+		},
+		Data: data,
+	})
+	return
+}
@@ -0,0 +1,142 @@
+package srambackup
+
+import (
+	"bytes"
+	"context"
+	"sni/cmd/sni/config"
+	"sni/internal/snestest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func useTempDir(t *testing.T) {
+	oldDir := config.Dir
+	config.Dir = t.TempDir()
+	t.Cleanup(func() { config.Dir = oldDir })
+}
+
+func TestRomKey(t *testing.T) {
+	for _, tc := range []struct {
+		rom      Rom
+		expected string
+	}{
+		{Rom{"SUPER METROID", 0xf8df}, "SUPER_METROID-f8df"},
+		{Rom{"ZELDANODENSETSU", 0x1}, "ZELDANODENSETSU-0001"},
+		{Rom{"../..", 0xabcd}, "untitled-abcd"},
+		{Rom{"", 0}, "untitled-0000"},
+	} {
+		if got := tc.rom.Key(); got != tc.expected {
+			t.Errorf("Key(%q) = %q, expected %q", tc.rom.Title, got, tc.expected)
+		}
+	}
+}
+
+func TestSaveDeduplicatesAndPrunes(t *testing.T) {
+	useTempDir(t)
+
+	rom := Rom{Title: "SUPER METROID", Checksum: 0xf8df}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	first, saved, err := Save(rom, []byte{1, 2, 3}, now, 2)
+	if err != nil || !saved {
+		t.Fatalf("expected first backup to be saved: %v", err)
+	}
+
+	// unchanged SRAM is not saved again:
+	same, saved, err := Save(rom, []byte{1, 2, 3}, now.Add(time.Minute), 2)
+	if err != nil || saved || same.ID != first.ID {
+		t.Fatalf("expected unchanged SRAM to be deduplicated: saved=%v id=%s err=%v", saved, same.ID, err)
+	}
+
+	if _, saved, err = Save(rom, []byte{4, 5, 6}, now.Add(2*time.Minute), 2); err != nil || !saved {
+		t.Fatalf("expected changed SRAM to be saved: %v", err)
+	}
+	// SRAM changed back to an older backup is not saved again either:
+	same, saved, err = Save(rom, []byte{1, 2, 3}, now.Add(150*time.Second), 2)
+	if err != nil || saved || same.ID != first.ID {
+		t.Fatalf("expected SRAM identical to an older backup to be deduplicated: saved=%v id=%s err=%v", saved, same.ID, err)
+	}
+	latest, saved, err := Save(rom, []byte{7, 8, 9}, now.Add(3*time.Minute), 2)
+	if err != nil || !saved {
+		t.Fatalf("expected changed SRAM to be saved: %v", err)
+	}
+
+	// only the 2 most recent backups are kept:
+	backups, err := List("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 || backups[0].ID != latest.ID {
+		t.Fatalf("expected 2 backups, most recent first: %+v", backups)
+	}
+	if backups[0].Rom != rom || backups[0].RomKey != rom.Key() || backups[0].Size != 3 {
+		t.Fatalf("unexpected backup %+v", backups[0])
+	}
+
+	b, data, err := Load(rom.Key(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.ID != latest.ID || !bytes.Equal(data, []byte{7, 8, 9}) {
+		t.Fatalf("expected latest backup to be loaded: %s % x", b.ID, data)
+	}
+
+	if _, _, err = Load(rom.Key(), first.ID); !snestest.IsCode(err, codes.NotFound) {
+		t.Fatalf("expected pruned backup to be NotFound but got %v", err)
+	}
+	if _, err = List("../etc"); !snestest.IsCode(err, codes.InvalidArgument) {
+		t.Fatalf("expected InvalidArgument but got %v", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	useTempDir(t)
+	ctx := context.Background()
+
+	m := &snestest.PakMemory{}
+	m.LoadROM("PRACTICE", 0x1234, 0x01)
+	rom, sram, _, ok, err := readSram(ctx, m, "test:device")
+	if err != nil || !ok {
+		t.Fatalf("expected SRAM to be read: %v", err)
+	}
+	if rom.Key() != "PRACTICE-1234" || len(sram.Data) != 0x800 {
+		t.Fatalf("unexpected rom %s with $%x bytes of SRAM", rom.Key(), len(sram.Data))
+	}
+
+	good := bytes.Repeat([]byte{0xAB}, 0x800)
+	saved, _, err := Save(rom, good, time.Now().Add(-time.Hour), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the cart glitches and wipes SRAM:
+	for i := 0; i < 0x800; i++ {
+		m.Memory[0xE0_0000+i] = 0xFF
+	}
+
+	restored, err := Restore(ctx, m, "test:device", rom.Key(), saved.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.ID != saved.ID || !bytes.Equal(m.Memory[0xE0_0000:0xE0_0800], good) {
+		t.Fatal("expected SRAM to be restored from backup")
+	}
+
+	// the wiped SRAM was backed up before restoring so the restore can be undone:
+	_, wiped, err := Load(rom.Key(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(wiped, bytes.Repeat([]byte{0xFF}, 0x800)) {
+		t.Fatal("expected SRAM to be backed up before restoring")
+	}
+
+	// restoring onto a different game requires force:
+	other := &snestest.PakMemory{}
+	other.LoadROM("OTHER", 0x4321, 0x01)
+	if _, err = Restore(ctx, other, "test:device", rom.Key(), saved.ID, false); !snestest.IsCode(err, codes.FailedPrecondition) {
+		t.Fatalf("expected FailedPrecondition but got %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package srambackup
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	backupExt    = ".srm"
+	romInfoFile  = "rom.json"
+	idTimeFormat = "20060102-150405.000"
+	// hashLength is the number of hex digits of the SHA-1 of the SRAM contents included in backup ids:
+	hashLength = 12
+)
+
+// Rom identifies the game a backup was taken from by the title and checksum in its ROM header.
+type Rom struct {
+	Title    string `json:"title"`
+	Checksum uint16 `json:"checksum"`
+}
+
+var unsafeKeyChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Key names the directory backups of the ROM are stored in, e.g. "SUPER_METROID-f8df".
+func (r Rom) Key() string {
+	title := strings.Trim(unsafeKeyChars.ReplaceAllString(r.Title, "_"), "_.")
+	if title == "" {
+		title = "untitled"
+	}
+	return fmt.Sprintf("%s-%04x", title, r.Checksum)
+}
+
+// Backup describes one stored copy of SRAM.
+type Backup struct {
+	Rom     Rom
+	RomKey  string
+	ID      string
+	Created time.Time
+	Size    uint32
+}
+
+// Dir returns the directory SRAM backups are stored in:
+func Dir() string {
+	return filepath.Join(config.Dir, "sram-backups")
+}
+
+var validKey = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func romDir(romKey string) (string, error) {
+	if !validKey.MatchString(romKey) || strings.Contains(romKey, "..") {
+		return "", devices.WithCode(codes.InvalidArgument, fmt.Errorf("srambackup: invalid rom '%s'", romKey))
+	}
+	return filepath.Join(Dir(), romKey), nil
+}
+
+func hashOf(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])[:hashLength]
+}
+
+// Save stores data as a new backup of the ROM's SRAM unless it is identical to any kept backup, in which case that
+// backup is returned and saved is false. Only the most recent keep backups of each ROM are kept if keep > 0.
+func Save(rom Rom, data []byte, now time.Time, keep int) (b Backup, saved bool, err error) {
+	var dir string
+	if dir, err = romDir(rom.Key()); err != nil {
+		return
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+
+	hash := hashOf(data)
+
+	var backups []Backup
+	if backups, err = list(rom.Key()); err != nil {
+		return
+	}
+	for _, existing := range backups {
+		if strings.HasSuffix(existing.ID, "_"+hash) {
+			return existing, false, nil
+		}
+	}
+
+	if len(backups) == 0 {
+		var romJson []byte
+		if romJson, err = json.Marshal(&rom); err != nil {
+			return
+		}
+		if err = os.WriteFile(filepath.Join(dir, romInfoFile), romJson, 0644); err != nil {
+			return
+		}
+	}
+
+	b = Backup{
+		Rom:     rom,
+		RomKey:  rom.Key(),
+		ID:      now.UTC().Format(idTimeFormat) + "_" + hash,
+		Created: now,
+		Size:    uint32(len(data)),
+	}
+
+	// write to a temporary file first so a partially written backup is never listed:
+	path := filepath.Join(dir, b.ID+backupExt)
+	if err = os.WriteFile(path+".tmp", data, 0644); err != nil {
+		return
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		_ = os.Remove(path + ".tmp")
+		return
+	}
+	saved = true
+
+	if keep > 0 {
+		backups = append([]Backup{b}, backups...)
+		for _, old := range backups[min(keep, len(backups)):] {
+			_ = os.Remove(filepath.Join(dir, old.ID+backupExt))
+		}
+	}
+	return
+}
+
+// List returns the backups of the ROM with the given key, or of all ROMs if romKey is empty, most recent first.
+func List(romKey string) (backups []Backup, err error) {
+	if romKey != "" {
+		return list(romKey)
+	}
+
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(Dir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !validKey.MatchString(entry.Name()) {
+			continue
+		}
+		var romBackups []Backup
+		if romBackups, err = list(entry.Name()); err != nil {
+			return
+		}
+		backups = append(backups, romBackups...)
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].Created.After(backups[j].Created)
+	})
+	return
+}
+
+func list(romKey string) (backups []Backup, err error) {
+	var dir string
+	if dir, err = romDir(romKey); err != nil {
+		return
+	}
+
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+
+	var rom Rom
+	if romJson, rerr := os.ReadFile(filepath.Join(dir, romInfoFile)); rerr == nil {
+		_ = json.Unmarshal(romJson, &rom)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != backupExt {
+			continue
+		}
+
+		id := strings.TrimSuffix(name, backupExt)
+		ts, _, ok := strings.Cut(id, "_")
+		if !ok {
+			continue
+		}
+		created, perr := time.Parse(idTimeFormat, ts)
+		if perr != nil {
+			continue
+		}
+
+		info, ierr := entry.Info()
+		if ierr != nil {
+			continue
+		}
+
+		backups = append(backups, Backup{
+			Rom:     rom,
+			RomKey:  romKey,
+			ID:      id,
+			Created: created,
+			Size:    uint32(info.Size()),
+		})
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].Created.After(backups[j].Created)
+	})
+	return
+}
+
+// Load reads the backup of the ROM with the given id, or its most recent backup if id is empty.
+func Load(romKey string, id string) (b Backup, data []byte, err error) {
+	var backups []Backup
+	if backups, err = list(romKey); err != nil {
+		return
+	}
+
+	found := false
+	for _, backup := range backups {
+		if id == "" || backup.ID == id {
+			b, found = backup, true
+			break
+		}
+	}
+	if !found {
+		err = devices.WithCode(codes.NotFound, fmt.Errorf("srambackup: no backup '%s' of rom '%s'", id, romKey))
+		return
+	}
+
+	data, err = os.ReadFile(filepath.Join(Dir(), b.RomKey, b.ID+backupExt))
+	return
+}
@@ -14,7 +14,10 @@ import (
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/devices/snes/mapping"
+	"sni/devices/snes/patch"
+	"sni/devices/snes/romhash"
 	"sni/protos/sni"
+	"sni/services/auth"
 	"sni/util"
 	"sni/util/hex"
 	"strconv"
@@ -23,8 +26,19 @@ import (
 
 	"github.com/gobwas/ws"
 	"github.com/gobwas/ws/wsutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+// maxIPSSize bounds the size of a PutIPS patch: a patch of all of ROM in records of the maximum size of 0xFFFF bytes
+// with their 5-byte headers, plus the "PATCH" header, "EOF" footer and 3-byte truncation size:
+const maxIPSSize = romhash.MaxSize + (romhash.MaxSize/0xFFFF+1)*5 + 5 + 3 + 3
+
+var connectedClients = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "sni_usb2snes_clients",
+	Help: "Number of usb2snes clients currently connected.",
+})
+
 func StartHttpServer() {
 	if config.Config.GetBool("usb2snes_disable") {
 		log.Printf("usb2snes: server disabled due to setting %s=%v\n", "SNI_USB2SNES_DISABLE", true)
@@ -136,7 +150,44 @@ func (w *wsWriter) Write(p []byte) (n int, err error) {
 	return
 }
 
+// opcodePermission returns the permissions required to run an opcode when authentication is enabled. public opcodes
+// may be run before the client has authenticated.
+func opcodePermission(opcode string, space string) (required auth.Permission, public bool) {
+	switch opcode {
+	case "Authenticate", "Name", "AppVersion", "Close":
+		return auth.PermissionNone, true
+	case "DeviceList", "Attach", "Info":
+		return auth.PermissionNone, false
+	case "GetAddress":
+		return auth.PermissionRead, false
+	case "PutAddress", "PutIPS":
+		if space == "CMD" {
+			// writing to CMD space executes code on the SNES:
+			return auth.PermissionWrite | auth.PermissionControl, false
+		}
+		return auth.PermissionWrite, false
+	case "Reset", "Menu":
+		return auth.PermissionControl, false
+	case "Boot":
+		return auth.PermissionFilesystem | auth.PermissionControl, false
+	case "List", "MakeDir", "Remove", "Rename", "GetFile", "PutFile":
+		return auth.PermissionFilesystem, false
+	default:
+		return auth.PermissionAll, false
+	}
+}
+
 func WebsocketHandler(rw http.ResponseWriter, req *http.Request) {
+	// clients may authenticate with a `?token=` query parameter or later with the Authenticate opcode:
+	token := req.URL.Query().Get("token")
+	if token != "" {
+		if _, err := auth.PermissionFor(token); err != nil {
+			log.Printf("usb2snes: %s: %v\n", req.RemoteAddr, err)
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
 	conn, _, _, err := ws.UpgradeHTTP(req, rw)
 	if err != nil {
 		log.Printf("usb2snes: %s: %v\n", req.RemoteAddr, err)
@@ -145,9 +196,11 @@ func WebsocketHandler(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	clientName := conn.RemoteAddr().String()
+	connectedClients.Inc()
 	defer func() {
 		log.Printf("usb2snes: %s: %s disconnected\n", clientName, conn.RemoteAddr())
 		conn.Close()
+		connectedClients.Dec()
 	}()
 
 	// setup general readers, writers and JSON encoders, decoders:
@@ -245,20 +298,33 @@ serverLoop:
 			return true
 		}
 
+		if required, public := opcodePermission(cmd.Opcode, cmd.Space); !public {
+			if err = auth.Check(token, required); err != nil {
+				log.Printf("usb2snes: %s: %s denied: %v\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+		}
+
 		switch cmd.Opcode {
+		case "Authenticate":
+			if len(cmd.Operands) != 1 {
+				log.Printf("usb2snes: %s: %s missing required operand\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			if _, err = auth.PermissionFor(cmd.Operands[0]); err != nil {
+				log.Printf("usb2snes: %s: %s failed: %v\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			token = cmd.Operands[0]
+			break
 		case "DeviceList":
-			descriptors := make([]devices.DeviceDescriptor, 0, 10)
-			for _, driver := range devices.Drivers() {
-				if config.VerboseLogging {
-					log.Printf("usb2snes: %s: %s detecting devices from driver '%s'\n", clientName, cmd.Opcode, driver.Name)
-				}
-				d, err := driver.Driver.Detect()
-				if err != nil {
-					log.Printf("usb2snes: %s: %s error detecting from driver '%s': %s\n", clientName, cmd.Opcode, driver.Name, err)
-					continue
-				}
-				descriptors = append(descriptors, d...)
+			if config.VerboseLogging {
+				log.Printf("usb2snes: %s: %s detecting devices\n", clientName, cmd.Opcode)
 			}
+			// detect through the devices package so that WatchDevices subscribers and the tray see the detection too:
+			descriptors := devices.DetectDevices()
 			if config.VerboseLogging {
 				log.Printf("usb2snes: %s: %s detection complete\n", clientName, cmd.Opcode)
 			}
@@ -588,6 +654,109 @@ serverLoop:
 			_ = rsps
 			break
 
+		case "PutIPS":
+			if device == nil {
+				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			// operands are the name of the patch, e.g. "hook", and its size:
+			if len(cmd.Operands) < 2 {
+				log.Printf("usb2snes: %s: %s expected 2 operands, got %d\n", clientName, cmd.Opcode, len(cmd.Operands))
+				break serverLoop
+			}
+
+			var size64 uint64
+			size64, err = strconv.ParseUint(cmd.Operands[1], 16, 32)
+			if err != nil {
+				// attempt parse again with unspecified base to parse `0x` prefix:
+				size64, err = strconv.ParseUint(cmd.Operands[1], 0, 32)
+				if err != nil {
+					log.Printf("usb2snes: %s: %s: bad operand [%d]: '%s'\n", clientName, cmd.Opcode, 1, cmd.Operands[1])
+					break serverLoop
+				}
+			}
+			if size64 > maxIPSSize {
+				log.Printf("usb2snes: %s: %s: patch size %#x exceeds maximum of %#x\n", clientName, cmd.Opcode, size64, maxIPSSize)
+				break serverLoop
+			}
+
+			var space uint32
+			switch s := strings.TrimSpace(strings.ToUpper(cmd.Space)); s {
+			case "SNES":
+				space = 0
+				break
+			case "CMD":
+				// same hack as PutAddress to put the CMD address space into the FxPakPro space:
+				space = 0x01_000000
+				break
+			default:
+				log.Printf("usb2snes: %s: %s: unrecognized space '%s'\n", clientName, cmd.Opcode, s)
+				break serverLoop
+			}
+
+			ips := make([]byte, size64)
+			_, err = io.ReadFull(&wsReader{r}, ips)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s read(): %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			var records []patch.Record
+			records, _, err = patch.ParseIPS(ips)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			reqs := make([]devices.MemoryWriteRequest, 0, len(records))
+			for _, record := range records {
+				if len(record.Data) == 0 {
+					continue
+				}
+				reqs = append(reqs, devices.MemoryWriteRequest{
+					RequestAddress: devices.AddressTuple{
+						Address:       record.Offset | space,
+						AddressSpace:  sni.AddressSpace_FxPakPro,
+						MemoryMapping: deviceMemoryMapping,
+					},
+					Data: record.Data,
+				})
+
+				// check if we need to know the memory mapping for this request:
+				if deviceMemoryMapping == sni.MemoryMapping_Unknown {
+					var requiresMemoryMapping bool
+					requiresMemoryMapping, err = device.RequiresMemoryMappingForAddress(context.Background(), reqs[len(reqs)-1].RequestAddress)
+					if requiresMemoryMapping {
+						// need to know memory mapping of ROM:
+						deviceMemoryMapping, _, _, err = mapping.Detect(context.Background(), device, nil, nil)
+						if err != nil {
+							log.Printf("usb2snes: %s: could not detect memory mapping: %s\n", clientName, err)
+							break serverLoop
+						}
+					}
+				}
+			}
+			// requests made before the memory mapping was detected need it too:
+			for i := range reqs {
+				reqs[i].RequestAddress.MemoryMapping = deviceMemoryMapping
+			}
+
+			if len(reqs) > 0 {
+				var rsps []devices.MemoryWriteResponse
+				rsps, err = device.MultiWriteMemory(context.Background(), reqs...)
+				// the ROM may have been partially written even on failure:
+				romhash.Forget(attachedUri.String())
+				if err != nil {
+					log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+					break serverLoop
+				}
+				if config.VerboseLogging {
+					log.Printf("usb2snes: %s: %s REPLY: %+v\n", clientName, cmd.Opcode, rsps)
+				}
+			}
+			break
+
 		case "Reset":
 			if device == nil {
 				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)